@@ -0,0 +1,85 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package hdwallet
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HardenedKeyStart is the index of the first hardened child key.
+const HardenedKeyStart uint32 = 1 << 31
+
+// DefaultBasePath is the Seele derivation path of accounts, and the account index
+// is appended to derive the account key, e.g. m/44'/5420'/0'/0/1 for account 1.
+var DefaultBasePath = DerivationPath{HardenedKeyStart + 44, HardenedKeyStart + 5420, HardenedKeyStart, 0}
+
+var errInvalidPath = errors.New("invalid derivation path")
+
+// DerivationPath is the BIP-32 path of child key indexes from the master key.
+type DerivationPath []uint32
+
+// ParseDerivationPath parses the derivation path, e.g. m/44'/5420'/0'/0/1, and the
+// hardened index is suffixed with ' or h. The path is relative to DefaultBasePath
+// if not started with m.
+func ParseDerivationPath(path string) (DerivationPath, error) {
+	components := strings.Split(strings.TrimSpace(path), "/")
+
+	var result DerivationPath
+	if components[0] == "m" {
+		components = components[1:]
+	} else {
+		result = append(result, DefaultBasePath...)
+	}
+
+	if len(components) == 0 || (len(components) == 1 && components[0] == "") {
+		return nil, errInvalidPath
+	}
+
+	for _, component := range components {
+		hardened := strings.HasSuffix(component, "'") || strings.HasSuffix(component, "h")
+		if hardened {
+			component = component[:len(component)-1]
+		}
+
+		index, err := strconv.ParseUint(strings.TrimSpace(component), 10, 32)
+		// the index is less than 2^31 in both forms, the hardened one is offset by HardenedKeyStart
+		if err != nil || index >= uint64(HardenedKeyStart) {
+			return nil, errInvalidPath
+		}
+
+		if hardened {
+			index += uint64(HardenedKeyStart)
+		}
+
+		result = append(result, uint32(index))
+	}
+
+	return result, nil
+}
+
+// Child returns the path of the child index.
+func (p DerivationPath) Child(index uint32) DerivationPath {
+	child := make(DerivationPath, len(p), len(p)+1)
+	copy(child, p)
+	return append(child, index)
+}
+
+// String returns the path in string, e.g. m/44'/5420'/0'/0/1.
+func (p DerivationPath) String() string {
+	result := "m"
+	for _, index := range p {
+		if index >= HardenedKeyStart {
+			result += fmt.Sprintf("/%d'", index-HardenedKeyStart)
+		} else {
+			result += fmt.Sprintf("/%d", index)
+		}
+	}
+
+	return result
+}
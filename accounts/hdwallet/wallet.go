@@ -0,0 +1,175 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package hdwallet
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultEntropyBits is the entropy bits of the mnemonic with 24 words.
+const DefaultEntropyBits = 256
+
+// maxShardSearch is the max number of indexes to search for the account in shard.
+const maxShardSearch = 1 << 16
+
+var (
+	masterKeySeed = []byte("Bitcoin seed")
+
+	errInvalidMnemonic = errors.New("invalid mnemonic")
+	errInvalidKey      = errors.New("invalid derived key")
+	errInvalidShard    = errors.New("invalid shard number")
+	errShardNotFound   = errors.New("no account found in shard")
+)
+
+// extendedKey is the private key with chain code to derive the child keys.
+type extendedKey struct {
+	key       []byte // 32 bytes private key
+	chainCode []byte // 32 bytes chain code
+}
+
+// Wallet is a BIP-32 hierarchical deterministic wallet, which derives the
+// account keys from the master key of a seed, e.g. the BIP-39 mnemonic.
+type Wallet struct {
+	master *extendedKey
+}
+
+// NewMnemonic generates a BIP-39 mnemonic with the entropy bits, which should be
+// a multiple of 32 in [128, 256].
+func NewMnemonic(bits int) (string, error) {
+	entropy, err := bip39.NewEntropy(bits)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(entropy)
+}
+
+// NewFromMnemonic creates a wallet from the BIP-39 mnemonic and the optional password.
+func NewFromMnemonic(mnemonic, password string) (*Wallet, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, password)
+	if err != nil {
+		return nil, errInvalidMnemonic
+	}
+
+	return NewFromSeed(seed)
+}
+
+// NewFromSeed creates a wallet from the seed.
+func NewFromSeed(seed []byte) (*Wallet, error) {
+	mac := hmac.New(sha512.New, masterKeySeed)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+
+	if !isValidKey(sum[:32]) {
+		return nil, errInvalidKey
+	}
+
+	return &Wallet{&extendedKey{sum[:32], sum[32:]}}, nil
+}
+
+// Derive derives the private key of the path.
+func (w *Wallet) Derive(path DerivationPath) (*ecdsa.PrivateKey, error) {
+	key := w.master
+	for _, index := range path {
+		var err error
+		if key, err = key.child(index); err != nil {
+			return nil, err
+		}
+	}
+
+	return crypto.ToECDSA(key.key)
+}
+
+// DeriveAccount derives the private key of the account index in DefaultBasePath.
+func (w *Wallet) DeriveAccount(index uint32) (*ecdsa.PrivateKey, error) {
+	return w.Derive(DefaultBasePath.Child(index))
+}
+
+// NextShardAccount walks the account indexes in DefaultBasePath from the specified
+// index, and returns the first account key in the shard and its index. The account
+// of the start index is returned if shard is 0.
+func (w *Wallet) NextShardAccount(shard uint, start uint32) (*ecdsa.PrivateKey, uint32, error) {
+	if shard > common.ShardNumber {
+		return nil, 0, errInvalidShard
+	}
+
+	for index := start; index-start < maxShardSearch && index < HardenedKeyStart; index++ {
+		key, err := w.DeriveAccount(index)
+		if err == errInvalidKey {
+			continue
+		}
+
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if shard == 0 || common.GetShardNumber(*crypto.MustGetAddress(key)) == shard {
+			return key, index, nil
+		}
+	}
+
+	return nil, 0, errShardNotFound
+}
+
+// child derives the child key of the index, see BIP-32 private parent key to private child key.
+func (k *extendedKey) child(index uint32) (*extendedKey, error) {
+	var data []byte
+	if index >= HardenedKeyStart {
+		data = append([]byte{0}, k.key...)
+	} else {
+		data = compressedPublicKey(k.key)
+	}
+
+	var indexBytes [4]byte
+	binary.BigEndian.PutUint32(indexBytes[:], index)
+	data = append(data, indexBytes[:]...)
+
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	if !isValidKey(sum[:32]) {
+		return nil, errInvalidKey
+	}
+
+	childKey := new(big.Int).SetBytes(sum[:32])
+	childKey.Add(childKey, new(big.Int).SetBytes(k.key))
+	childKey.Mod(childKey, crypto.S256().Params().N)
+
+	if childKey.Sign() == 0 {
+		return nil, errInvalidKey
+	}
+
+	return &extendedKey{math.PaddedBigBytes(childKey, 32), sum[32:]}, nil
+}
+
+// isValidKey returns whether the key is in [1, N-1] of the curve.
+func isValidKey(key []byte) bool {
+	k := new(big.Int).SetBytes(key)
+	return k.Sign() > 0 && k.Cmp(crypto.S256().Params().N) < 0
+}
+
+// compressedPublicKey returns the 33 bytes compressed public key of the private key.
+func compressedPublicKey(key []byte) []byte {
+	x, y := crypto.S256().ScalarBaseMult(key)
+
+	prefix := byte(2)
+	if y.Bit(0) == 1 {
+		prefix = 3
+	}
+
+	return append([]byte{prefix}, math.PaddedBigBytes(x, 32)...)
+}
@@ -0,0 +1,96 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package hdwallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+func Test_NewFromSeed(t *testing.T) {
+	// BIP-32 test vector 1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	wallet, err := NewFromSeed(seed)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, hex.EncodeToString(wallet.master.key), "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35")
+	assert.Equal(t, hex.EncodeToString(wallet.master.chainCode), "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508")
+
+	key, err := wallet.Derive(DerivationPath{HardenedKeyStart})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, hex.EncodeToString(crypto.FromECDSA(key)), "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea")
+
+	path, err := ParseDerivationPath("m/0'/1")
+	assert.Equal(t, err, nil)
+	key, err = wallet.Derive(path)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, hex.EncodeToString(crypto.FromECDSA(key)), "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368")
+}
+
+func Test_NewFromMnemonic(t *testing.T) {
+	mnemonic, err := NewMnemonic(DefaultEntropyBits)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(strings.Fields(mnemonic)), 24)
+
+	wallet1, err := NewFromMnemonic(mnemonic, "pass")
+	assert.Equal(t, err, nil)
+	wallet2, err := NewFromMnemonic(mnemonic, "pass")
+	assert.Equal(t, err, nil)
+
+	key1, _ := wallet1.DeriveAccount(1)
+	key2, _ := wallet2.DeriveAccount(1)
+	assert.Equal(t, crypto.FromECDSA(key1), crypto.FromECDSA(key2))
+
+	// different password
+	wallet3, _ := NewFromMnemonic(mnemonic, "")
+	key3, _ := wallet3.DeriveAccount(1)
+	assert.Equal(t, hex.EncodeToString(crypto.FromECDSA(key1)) == hex.EncodeToString(crypto.FromECDSA(key3)), false)
+
+	_, err = NewFromMnemonic("invalid mnemonic words", "")
+	assert.Equal(t, err, errInvalidMnemonic)
+}
+
+func Test_NextShardAccount(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	wallet, _ := NewFromSeed(seed)
+
+	for shard := uint(1); shard <= 3; shard++ {
+		key, index, err := wallet.NextShardAccount(shard, 5)
+		assert.Equal(t, err, nil)
+		assert.Equal(t, index >= 5, true)
+		assert.Equal(t, common.GetShardNumber(*crypto.MustGetAddress(key)), shard)
+
+		expected, _ := wallet.DeriveAccount(index)
+		assert.Equal(t, crypto.FromECDSA(key), crypto.FromECDSA(expected))
+	}
+
+	_, index, err := wallet.NextShardAccount(0, 5)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, index, uint32(5))
+
+	_, _, err = wallet.NextShardAccount(common.ShardNumber+1, 0)
+	assert.Equal(t, err, errInvalidShard)
+}
+
+func Test_ParseDerivationPath(t *testing.T) {
+	path, err := ParseDerivationPath("m/44'/5420'/0'/0/1")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, path.String(), "m/44'/5420'/0'/0/1")
+	assert.Equal(t, path.String(), DefaultBasePath.Child(1).String())
+
+	path, err = ParseDerivationPath("2")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, path.String(), DefaultBasePath.Child(2).String())
+
+	for _, invalid := range []string{"", "m", "m/", "m/a", "m/1/-1", "m/2147483648'", "m/4294967296", "m/2147483648"} {
+		_, err = ParseDerivationPath(invalid)
+		assert.Equal(t, err, errInvalidPath, invalid)
+	}
+}
@@ -0,0 +1,330 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+// KeystoreDirName is the name of keystore folder in the node data folder.
+const KeystoreDirName = "keystore"
+
+var (
+	errAccountNotFound = errors.New("account not found")
+	errAccountLocked   = errors.New("account locked, unlock it first")
+	errAddressMismatch = errors.New("key file address mismatch with the decrypted key")
+)
+
+// Account is an account in keystore.
+type Account struct {
+	Address common.Address `json:"address"`
+	File    string         `json:"file"` // path of the key file
+}
+
+// unlockedKey is the decrypted key of the unlocked account.
+type unlockedKey struct {
+	*keystore.Key
+	abort chan struct{} // closed when the account is locked before timeout
+}
+
+// ExternalSigner signs with the accounts out of the process, e.g. the signer daemon.
+type ExternalSigner interface {
+	// Accounts returns the addresses of the accounts in external signer.
+	Accounts() ([]common.Address, error)
+
+	// SignTx signs the transaction with the account of sender.
+	SignTx(tx *types.Transaction) error
+
+	// SignMessage signs the personal message, see crypto.SignMessage.
+	SignMessage(address common.Address, message []byte) (*crypto.Signature, error)
+}
+
+// Manager manages the accounts of the key files in the keystore folder, and signs
+// with the unlocked accounts, so that the private keys need not leave the node.
+// The accounts not unlocked are delegated to the external signer if specified.
+type Manager struct {
+	dir      string
+	lock     sync.RWMutex
+	unlocked map[common.Address]*unlockedKey
+	external ExternalSigner
+}
+
+// KeystoreDir returns the keystore folder in the specified node data folder.
+func KeystoreDir(dataDir string) string {
+	return filepath.Join(dataDir, KeystoreDirName)
+}
+
+// NewManager creates an account manager of the specified keystore folder.
+func NewManager(dir string) *Manager {
+	return &Manager{
+		dir:      dir,
+		unlocked: make(map[common.Address]*unlockedKey),
+	}
+}
+
+// Accounts returns the accounts of the key files in keystore folder, sorted by file name.
+// The hidden files and invalid key files are ignored.
+func (m *Manager) Accounts() ([]Account, error) {
+	files, err := ioutil.ReadDir(m.dir)
+	if err != nil {
+		if common.FileOrFolderExists(m.dir) {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	var accounts []Account
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(m.dir, file.Name())
+		address, err := keystore.GetAddress(path)
+		if err != nil {
+			continue
+		}
+
+		accounts = append(accounts, Account{address, path})
+	}
+
+	return accounts, nil
+}
+
+// Find returns the account of the specified address.
+func (m *Manager) Find(address common.Address) (Account, error) {
+	accounts, err := m.Accounts()
+	if err != nil {
+		return Account{}, err
+	}
+
+	for _, account := range accounts {
+		if account.Address.Equal(address) {
+			return account, nil
+		}
+	}
+
+	return Account{}, errAccountNotFound
+}
+
+// NewAccount generates a key, and stores it in keystore folder encrypted with the password.
+func (m *Manager) NewAccount(password string) (Account, error) {
+	address, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		return Account{}, err
+	}
+
+	return m.Import(&keystore.Key{Address: *address, PrivateKey: privateKey}, password)
+}
+
+// Import stores the key in keystore folder encrypted with the password.
+func (m *Manager) Import(key *keystore.Key, password string) (Account, error) {
+	file := filepath.Join(m.dir, keyFileName(key.Address))
+	if err := keystore.StoreKey(file, password, key); err != nil {
+		return Account{}, err
+	}
+
+	return Account{key.Address, file}, nil
+}
+
+// Unlock decrypts the key of account with the password, and keeps it in memory to sign.
+// The account is locked after the timeout, or kept unlocked until Lock if timeout is 0.
+func (m *Manager) Unlock(address common.Address, password string, timeout time.Duration) error {
+	key, err := m.getDecryptedKey(address, password)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if u, ok := m.unlocked[address]; ok {
+		close(u.abort)
+	}
+
+	u := &unlockedKey{key, make(chan struct{})}
+	m.unlocked[address] = u
+
+	if timeout > 0 {
+		go m.expire(address, u, timeout)
+	}
+
+	return nil
+}
+
+// expire locks the account after the timeout unless locked or unlocked again.
+func (m *Manager) expire(address common.Address, u *unlockedKey, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		m.lock.Lock()
+		if m.unlocked[address] == u {
+			delete(m.unlocked, address)
+		}
+		m.lock.Unlock()
+	case <-u.abort:
+	}
+}
+
+// Lock removes the decrypted key of account from memory.
+func (m *Manager) Lock(address common.Address) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if u, ok := m.unlocked[address]; ok {
+		close(u.abort)
+		delete(m.unlocked, address)
+	}
+}
+
+// Unlocked returns whether the account is unlocked.
+func (m *Manager) Unlocked(address common.Address) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	_, ok := m.unlocked[address]
+	return ok
+}
+
+// SetExternalSigner sets the external signer to delegate the accounts not unlocked.
+func (m *Manager) SetExternalSigner(external ExternalSigner) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.external = external
+}
+
+// Close locks all the unlocked accounts.
+func (m *Manager) Close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for address, u := range m.unlocked {
+		close(u.abort)
+		delete(m.unlocked, address)
+	}
+}
+
+// SignTx signs the transaction with the unlocked account of the transaction sender,
+// or the external signer if the account is not unlocked.
+func (m *Manager) SignTx(tx *types.Transaction) error {
+	key, err := m.getUnlockedKey(tx.Data.From)
+	if external := m.getExternalSigner(); err == errAccountLocked && external != nil {
+		return external.SignTx(tx)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	tx.Sign(key.PrivateKey)
+	return nil
+}
+
+// SignTxWithPassword signs the transaction with the account of the transaction sender
+// decrypted by the password, and the account is not unlocked.
+func (m *Manager) SignTxWithPassword(tx *types.Transaction, password string) error {
+	key, err := m.getDecryptedKey(tx.Data.From, password)
+	if err != nil {
+		return err
+	}
+
+	tx.Sign(key.PrivateKey)
+	return nil
+}
+
+// SignHash signs the hash with the unlocked account, and the signature is recoverable.
+func (m *Manager) SignHash(address common.Address, hash []byte) (*crypto.Signature, error) {
+	key, err := m.getUnlockedKey(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.NewRecoverableSignature(key.PrivateKey, hash), nil
+}
+
+// SignMessage signs the personal message with the unlocked account, or the external
+// signer if the account is not unlocked, see crypto.SignMessage.
+func (m *Manager) SignMessage(address common.Address, message []byte) (*crypto.Signature, error) {
+	key, err := m.getUnlockedKey(address)
+	if external := m.getExternalSigner(); err == errAccountLocked && external != nil {
+		return external.SignMessage(address, message)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.SignMessage(key.PrivateKey, message), nil
+}
+
+// SignHashWithPassword signs the hash with the account decrypted by the password,
+// and the account is not unlocked. The signature is recoverable.
+func (m *Manager) SignHashWithPassword(address common.Address, hash []byte, password string) (*crypto.Signature, error) {
+	key, err := m.getDecryptedKey(address, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.NewRecoverableSignature(key.PrivateKey, hash), nil
+}
+
+func (m *Manager) getUnlockedKey(address common.Address) (*keystore.Key, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	u, ok := m.unlocked[address]
+	if !ok {
+		return nil, errAccountLocked
+	}
+
+	return u.Key, nil
+}
+
+func (m *Manager) getExternalSigner() ExternalSigner {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.external
+}
+
+func (m *Manager) getDecryptedKey(address common.Address, password string) (*keystore.Key, error) {
+	account, err := m.Find(address)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.GetKey(account.File, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.Address.Equal(address) {
+		return nil, errAddressMismatch
+	}
+
+	return key, nil
+}
+
+// keyFileName returns the key file name of the address, e.g. UTC--<created time>--<address hex>.
+func keyFileName(address common.Address) string {
+	created := time.Now().UTC().Format("2006-01-02T15-04-05.000000000Z")
+	return fmt.Sprintf("UTC--%s--%s", created, strings.TrimPrefix(address.ToHex(), "0x"))
+}
@@ -0,0 +1,100 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package accounts
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+func newTestManager(t *testing.T) (*Manager, func()) {
+	dir, err := ioutil.TempDir("", "accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewManager(filepath.Join(dir, KeystoreDirName)), func() { os.RemoveAll(dir) }
+}
+
+func Test_Manager_Accounts(t *testing.T) {
+	manager, dispose := newTestManager(t)
+	defer dispose()
+
+	// keystore folder not created yet
+	accounts, err := manager.Accounts()
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(accounts), 0)
+
+	account, err := manager.NewAccount("123")
+	assert.Equal(t, err, nil)
+
+	// hidden and invalid files are ignored
+	ioutil.WriteFile(filepath.Join(manager.dir, ".hidden"), []byte("{}"), 0600)
+	ioutil.WriteFile(filepath.Join(manager.dir, "invalid"), []byte("invalid"), 0600)
+
+	accounts, err = manager.Accounts()
+	assert.Equal(t, err, nil)
+	assert.Equal(t, accounts, []Account{account})
+
+	found, err := manager.Find(account.Address)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, found, account)
+
+	_, err = manager.Find(*crypto.MustGenerateRandomAddress())
+	assert.Equal(t, err, errAccountNotFound)
+}
+
+func Test_Manager_Unlock(t *testing.T) {
+	manager, dispose := newTestManager(t)
+	defer dispose()
+
+	address, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = manager.Import(&keystore.Key{Address: *address, PrivateKey: privateKey}, "123")
+	assert.Equal(t, err, nil)
+
+	tx, err := types.NewTransaction(*address, *crypto.MustGenerateRandomAddress(), big.NewInt(1), big.NewInt(1), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, manager.SignTx(tx), errAccountLocked)
+	assert.Equal(t, manager.Unlock(*address, "456", 0), keystore.ErrDecrypt)
+
+	// unlocked until locked
+	assert.Equal(t, manager.Unlock(*address, "123", 0), nil)
+	assert.Equal(t, manager.SignTx(tx), nil)
+	assert.Equal(t, tx.Signature.Verify(address, tx.Hash.Bytes()), true)
+
+	manager.Lock(*address)
+	assert.Equal(t, manager.Unlocked(*address), false)
+
+	// unlocked until timeout
+	assert.Equal(t, manager.Unlock(*address, "123", 50*time.Millisecond), nil)
+	assert.Equal(t, manager.Unlocked(*address), true)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, manager.Unlocked(*address), false)
+
+	// signed without unlock
+	hash := crypto.HashBytes([]byte("message")).Bytes()
+	sig, err := manager.SignHashWithPassword(*address, hash, "123")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, sig.Verify(address, hash), true)
+	assert.Equal(t, manager.Unlocked(*address), false)
+}
@@ -0,0 +1,96 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package signer
+
+import (
+	"net"
+	"time"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// DefaultTimeout is the default timeout of each request to the external signer,
+// which includes the time for the user to approve the request.
+const DefaultTimeout = 2 * time.Minute
+
+// Client is the client of external signer, which connects to the signer daemon
+// for each request, so that the daemon could be started or restarted at any time.
+type Client struct {
+	address string
+	timeout time.Duration
+}
+
+// NewClient creates a client of the external signer at the TCP address, and each
+// request fails after the timeout, or DefaultTimeout if timeout is 0.
+func NewClient(address string, timeout time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Client{address, timeout}
+}
+
+// Accounts returns the addresses of the accounts in external signer.
+func (c *Client) Accounts() ([]common.Address, error) {
+	var addresses []common.Address
+	err := c.call("ListAccounts", nil, &addresses)
+	return addresses, err
+}
+
+// SignTx signs the transaction with the account of sender in external signer.
+func (c *Client) SignTx(tx *types.Transaction) error {
+	signed := new(types.Transaction)
+	if err := c.call("SignTx", tx, signed); err != nil {
+		return err
+	}
+
+	// the signer may not change the tx data
+	hash := crypto.MustHash(tx.Data)
+	if !hash.Equal(signed.Hash) || signed.Signature == nil || !signed.Signature.Verify(&tx.Data.From, hash.Bytes()) {
+		return errInvalidTx
+	}
+
+	tx.Hash, tx.Signature = signed.Hash, signed.Signature
+	return nil
+}
+
+// SignMessage signs the personal message with the account in external signer.
+func (c *Client) SignMessage(address common.Address, message []byte) (*crypto.Signature, error) {
+	var result string
+	request := &SignMessageRequest{address, hexutil.BytesToHex(message)}
+	if err := c.call("SignMessage", request, &result); err != nil {
+		return nil, err
+	}
+
+	sigBytes, err := hexutil.HexToBytes(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.BytesToSignature(sigBytes)
+}
+
+func (c *Client) call(method string, args interface{}, result interface{}) error {
+	conn, err := net.DialTimeout("tcp", c.address, c.timeout)
+	if err != nil {
+		return err
+	}
+
+	// the connection is closed by client, and the pending call fails after deadline
+	if err = conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client := rpc.NewClient(conn)
+	defer client.Close()
+
+	return client.Call(Namespace+"."+method, args, result)
+}
@@ -0,0 +1,55 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package signer
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+)
+
+var (
+	errAmountExceeded      = errors.New("amount exceeds the max amount of rules")
+	errRecipientNotAllowed = errors.New("recipient is not allowed by rules")
+)
+
+// Rules are the rules of the transactions to sign, which are checked
+// before asking the user for approval.
+type Rules struct {
+	// MaxAmount is the max amount of a transaction, no limit if nil.
+	MaxAmount *big.Int
+
+	// AllowedRecipients are the allowed receivers of transactions, any receiver
+	// is allowed if empty. Contract creation is not allowed if not empty.
+	AllowedRecipients []common.Address
+}
+
+// CheckTx checks whether the transaction complies with the rules.
+func (r *Rules) CheckTx(tx *types.Transaction) error {
+	if r == nil {
+		return nil
+	}
+
+	if r.MaxAmount != nil && tx.Data.Amount.Cmp(r.MaxAmount) > 0 {
+		return errAmountExceeded
+	}
+
+	if len(r.AllowedRecipients) == 0 {
+		return nil
+	}
+
+	if tx.Data.To != nil {
+		for _, allowed := range r.AllowedRecipients {
+			if allowed.Equal(*tx.Data.To) {
+				return nil
+			}
+		}
+	}
+
+	return errRecipientNotAllowed
+}
@@ -0,0 +1,156 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+// Package signer implements the external signer protocol, which isolates the private
+// keys from the node and client processes. The signer daemon serves the JSON-RPC API
+// in the namespace "signer" with the methods below, and each signing request should
+// be approved by the user of the daemon and comply with the rules.
+//
+//	signer.ListAccounts(null) => [address]
+//	signer.SignTx(tx) => signed tx
+//	signer.SignMessage({"address": address, "message": HEX}) => signature in HEX
+package signer
+
+import (
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// Namespace is the namespace of the external signer API.
+const Namespace = "signer"
+
+var (
+	// ErrRejected is returned when the request is rejected by the user.
+	ErrRejected = errors.New("request rejected by user")
+
+	errInvalidTx = errors.New("invalid transaction")
+)
+
+// SignMessageRequest is the request to sign the personal message.
+type SignMessageRequest struct {
+	Address common.Address `json:"address"`
+	Message string         `json:"message"` // message in HEX
+}
+
+// UI is the user interface to approve the signing requests.
+type UI interface {
+	// ApproveTx shows the tx to user, and returns the password of the sender
+	// account if approved, otherwise ErrRejected.
+	ApproveTx(tx *types.Transaction) (string, error)
+
+	// ApproveMessage shows the message to user, and returns the password of the
+	// account if approved, otherwise ErrRejected.
+	ApproveMessage(address common.Address, message []byte) (string, error)
+}
+
+// API is the external signer API backed by the key files in keystore folder.
+type API struct {
+	manager *accounts.Manager
+	ui      UI
+	rules   *Rules
+	lock    sync.Mutex // approves the requests one by one
+}
+
+// NewAPI creates the external signer API of the keystore folder.
+func NewAPI(keystoreDir string, ui UI, rules *Rules) *API {
+	return &API{
+		manager: accounts.NewManager(keystoreDir),
+		ui:      ui,
+		rules:   rules,
+	}
+}
+
+// ListAccounts returns the addresses of the accounts in keystore.
+func (api *API) ListAccounts(input interface{}, result *[]common.Address) error {
+	list, err := api.manager.Accounts()
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]common.Address, 0, len(list))
+	for _, account := range list {
+		addresses = append(addresses, account.Address)
+	}
+
+	*result = addresses
+	return nil
+}
+
+// SignTx signs the transaction with the account of sender if it complies with
+// the rules and is approved by the user.
+func (api *API) SignTx(tx *types.Transaction, result *types.Transaction) error {
+	if tx.Data == nil || tx.Data.Amount == nil || tx.Data.Fee == nil {
+		return errInvalidTx
+	}
+
+	if err := api.rules.CheckTx(tx); err != nil {
+		return err
+	}
+
+	api.lock.Lock()
+	defer api.lock.Unlock()
+
+	password, err := api.ui.ApproveTx(tx)
+	if err != nil {
+		return err
+	}
+
+	if err = api.manager.SignTxWithPassword(tx, password); err != nil {
+		return err
+	}
+
+	*result = *tx
+	return nil
+}
+
+// SignMessage signs the personal message with the account if approved by the user,
+// and returns the signature in HEX, see crypto.SignMessage.
+func (api *API) SignMessage(request *SignMessageRequest, result *string) error {
+	message, err := hexutil.HexToBytes(request.Message)
+	if err != nil {
+		return err
+	}
+
+	api.lock.Lock()
+	defer api.lock.Unlock()
+
+	password, err := api.ui.ApproveMessage(request.Address, message)
+	if err != nil {
+		return err
+	}
+
+	sig, err := api.manager.SignHashWithPassword(request.Address, crypto.MessageHash(message).Bytes(), password)
+	if err != nil {
+		return err
+	}
+
+	*result = hexutil.BytesToHex(sig.Bytes())
+	return nil
+}
+
+// Serve serves the API in JSON-RPC on the listener until it is closed.
+func Serve(listener net.Listener, api *API) error {
+	server := rpc.NewServer()
+	if err := server.RegisterName(Namespace, api); err != nil {
+		return err
+	}
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go server.ServeCodec(rpc.NewJSONCodec(conn, &server.Server))
+	}
+}
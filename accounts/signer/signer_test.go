@@ -0,0 +1,191 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package signer
+
+import (
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+// mockUI approves the requests with the password if not rejected.
+type mockUI struct {
+	password string
+	rejected bool
+	requests int
+	delay    time.Duration // time for the user to approve
+}
+
+func (ui *mockUI) ApproveTx(tx *types.Transaction) (string, error) {
+	return ui.approve()
+}
+
+func (ui *mockUI) ApproveMessage(address common.Address, message []byte) (string, error) {
+	return ui.approve()
+}
+
+func (ui *mockUI) approve() (string, error) {
+	ui.requests++
+	time.Sleep(ui.delay)
+	if ui.rejected {
+		return "", ErrRejected
+	}
+
+	return ui.password, nil
+}
+
+func newTestSigner(t *testing.T, rules *Rules) (*Client, *mockUI, common.Address, func()) {
+	dir, err := ioutil.TempDir("", "signer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account, err := accounts.NewManager(dir).NewAccount("123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ui := &mockUI{password: "123"}
+	go Serve(listener, NewAPI(dir, ui, rules))
+
+	dispose := func() {
+		listener.Close()
+		os.RemoveAll(dir)
+	}
+
+	return NewClient(listener.Addr().String(), 0), ui, account.Address, dispose
+}
+
+func newTestTx(t *testing.T, from, to common.Address, amount int64) *types.Transaction {
+	tx, err := types.NewTransaction(from, to, big.NewInt(amount), big.NewInt(1), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tx
+}
+
+func Test_Signer_Accounts(t *testing.T) {
+	client, _, address, dispose := newTestSigner(t, nil)
+	defer dispose()
+
+	addresses, err := client.Accounts()
+	assert.Equal(t, err, nil)
+	assert.Equal(t, addresses, []common.Address{address})
+}
+
+func Test_Signer_SignTx(t *testing.T) {
+	client, ui, address, dispose := newTestSigner(t, nil)
+	defer dispose()
+
+	tx := newTestTx(t, address, *crypto.MustGenerateRandomAddress(), 10)
+	assert.Equal(t, client.SignTx(tx), nil)
+	assert.Equal(t, tx.Signature.Verify(&address, tx.Hash.Bytes()), true)
+	assert.Equal(t, ui.requests, 1)
+
+	// rejected by user
+	ui.rejected = true
+	tx = newTestTx(t, address, *crypto.MustGenerateRandomAddress(), 10)
+	assert.Equal(t, strings.Contains(client.SignTx(tx).Error(), ErrRejected.Error()), true)
+	assert.Equal(t, tx.Signature == nil, true)
+
+	// wrong password
+	ui.rejected, ui.password = false, "456"
+	assert.Equal(t, client.SignTx(tx) != nil, true)
+	assert.Equal(t, tx.Signature == nil, true)
+}
+
+func Test_Signer_Timeout(t *testing.T) {
+	client, ui, address, dispose := newTestSigner(t, nil)
+	defer dispose()
+
+	ui.delay = time.Second
+	client = NewClient(client.address, 100*time.Millisecond)
+
+	tx := newTestTx(t, address, *crypto.MustGenerateRandomAddress(), 10)
+	start := time.Now()
+	assert.Equal(t, client.SignTx(tx) != nil, true)
+	assert.Equal(t, time.Since(start) < ui.delay, true)
+}
+
+func Test_Signer_Rules(t *testing.T) {
+	to := *crypto.MustGenerateRandomAddress()
+	rules := &Rules{MaxAmount: big.NewInt(100), AllowedRecipients: []common.Address{to}}
+
+	client, ui, address, dispose := newTestSigner(t, rules)
+	defer dispose()
+
+	assert.Equal(t, client.SignTx(newTestTx(t, address, to, 100)), nil)
+
+	// the user is not asked if the rules are not complied
+	assert.Equal(t, strings.Contains(client.SignTx(newTestTx(t, address, to, 101)).Error(), errAmountExceeded.Error()), true)
+	assert.Equal(t, strings.Contains(client.SignTx(newTestTx(t, address, *crypto.MustGenerateRandomAddress(), 1)).Error(), errRecipientNotAllowed.Error()), true)
+	assert.Equal(t, ui.requests, 1)
+
+	// contract creation is not allowed
+	tx, err := types.NewContractTransaction(address, big.NewInt(0), big.NewInt(1), 0, []byte("code"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, rules.CheckTx(tx), errRecipientNotAllowed)
+
+	var noRules *Rules
+	assert.Equal(t, noRules.CheckTx(tx), nil)
+}
+
+func Test_Signer_SignMessage(t *testing.T) {
+	client, ui, address, dispose := newTestSigner(t, nil)
+	defer dispose()
+
+	message := []byte("message")
+	sig, err := client.SignMessage(address, message)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, crypto.VerifyMessage(&address, message, sig), true)
+
+	ui.rejected = true
+	_, err = client.SignMessage(address, message)
+	assert.Equal(t, strings.Contains(err.Error(), ErrRejected.Error()), true)
+}
+
+func Test_Manager_ExternalSigner(t *testing.T) {
+	client, _, address, dispose := newTestSigner(t, nil)
+	defer dispose()
+
+	dir, err := ioutil.TempDir("", "accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// delegated to the external signer if the account is not unlocked in manager
+	manager := accounts.NewManager(dir)
+	manager.SetExternalSigner(client)
+
+	tx := newTestTx(t, address, *crypto.MustGenerateRandomAddress(), 10)
+	assert.Equal(t, manager.SignTx(tx), nil)
+	assert.Equal(t, tx.Signature.Verify(&address, tx.Hash.Bytes()), true)
+
+	message := []byte("message")
+	sig, err := manager.SignMessage(address, message)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, crypto.VerifyMessage(&address, message, sig), true)
+}
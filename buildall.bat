@@ -15,4 +15,7 @@ go build -o ./build/node.exe ./cmd/node
 go build -o ./build/client.exe ./cmd/client
 @echo "Done client building"
 
+go build -o ./build/signer.exe ./cmd/signer
+@echo "Done signer building"
+
 pause
\ No newline at end of file
@@ -0,0 +1,152 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/spf13/cobra"
+)
+
+var accountAddress string
+var unlockDuration time.Duration
+
+// accountCmd represents the command to manage the accounts in the node keystore
+var accountCmd = &cobra.Command{
+	Use:   "account",
+	Short: "manage the accounts in the node keystore",
+	Long: `manage the accounts in the keystore folder of node, which sign transactions in node
+  once unlocked, e.g. "client sendtx --from 0x<address>".
+  For example:
+    client.exe account list
+    client.exe account new
+    client.exe account unlock --address 0x<address> [--duration 5m]
+    client.exe account lock --address 0x<address>`,
+}
+
+// accountListCmd represents the command to list accounts
+var accountListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the accounts in the node keystore",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		accounts, err := client.ListAccounts(ctx)
+		if err != nil {
+			return nil, callError(err)
+		}
+
+		return accounts, nil
+	}),
+}
+
+// accountNewCmd represents the command to create account
+var accountNewCmd = &cobra.Command{
+	Use:   "new",
+	Short: "create an account in the node keystore",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		pass, err := common.SetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password err %s", err.Error())
+		}
+
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		address, err := client.NewAccount(ctx, pass)
+		if err != nil {
+			return nil, callError(err)
+		}
+
+		return address.ToHex(), nil
+	}),
+}
+
+// accountUnlockCmd represents the command to unlock account
+var accountUnlockCmd = &cobra.Command{
+	Use:   "unlock",
+	Short: "unlock the account in the node keystore to sign in node",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		address, err := common.HexToAddress(accountAddress)
+		if err != nil {
+			return nil, invalidInputError("invalid account address: %s", err.Error())
+		}
+
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password failed %s", err.Error())
+		}
+
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		if err = client.UnlockAccount(ctx, address, pass, unlockDuration); err != nil {
+			return nil, callError(err)
+		}
+
+		return nil, nil
+	}),
+}
+
+// accountLockCmd represents the command to lock account
+var accountLockCmd = &cobra.Command{
+	Use:   "lock",
+	Short: "lock the unlocked account in the node keystore",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		address, err := common.HexToAddress(accountAddress)
+		if err != nil {
+			return nil, invalidInputError("invalid account address: %s", err.Error())
+		}
+
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		if err = client.LockAccount(ctx, address); err != nil {
+			return nil, callError(err)
+		}
+
+		return nil, nil
+	}),
+}
+
+func init() {
+	rootCmd.AddCommand(accountCmd)
+	accountCmd.AddCommand(accountListCmd, accountNewCmd, accountUnlockCmd, accountLockCmd)
+
+	for _, cmd := range []*cobra.Command{accountUnlockCmd, accountLockCmd} {
+		cmd.Flags().StringVar(&accountAddress, "address", "", "account address")
+		cmd.MarkFlagRequired("address")
+	}
+
+	accountUnlockCmd.Flags().DurationVar(&unlockDuration, "duration", 5*time.Minute, "duration to keep the account unlocked, until locked if 0")
+}
@@ -6,13 +6,11 @@
 package cmd
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 
-	"github.com/gorilla/websocket"
-	"github.com/seeleteam/go-seele/rpc"
+	"github.com/seeleteam/go-seele/seeleclient"
 	"github.com/spf13/cobra"
 )
 
@@ -21,8 +19,7 @@ func init() {
 	for _, request := range config.request {
 		cmd, err := config.InitCommand(request)
 		if err != nil {
-			fmt.Println("init cmd fail :\n", err)
-			return
+			exitWithError(fmt.Errorf("init cmd %s failed: %s", request.Use, err.Error()))
 		}
 		rootCmd.AddCommand(cmd)
 	}
@@ -42,7 +39,7 @@ func (c *Config) InitCommand(request *Request) (*cobra.Command, error) {
 		Use:   request.Use,
 		Short: request.Short,
 		Long:  request.Long,
-		Run: func(cmd *cobra.Command, args []string) {
+		Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
 			var input interface{}
 			if isBasic && request.ParamReflectType != "nil" {
 				input = ParamFlags[request.Params[0].ReflectName]
@@ -56,42 +53,30 @@ func (c *Config) InitCommand(request *Request) (*cobra.Command, error) {
 				input = e.Interface()
 			}
 
+			ctx, cancel := newContext()
+			defer cancel()
+
 			var output interface{}
-			var client *rpc.Client
+			var client *seeleclient.Client
 			var err error
 
 			if request.UseWebsocket {
-				ws, _, err := websocket.DefaultDialer.Dial(wsAddr, nil)
-				if err != nil {
-					fmt.Println(err)
-					return
-				}
-				defer ws.Close()
-
-				client = rpc.NewClient(ws.UnderlyingConn())
-				defer client.Close()
+				client, err = dialWebsocket(ctx)
 			} else {
-				client, err = rpc.Dial("tcp", rpcAddr)
-				if err != nil {
-					fmt.Println(err)
-					return
-				}
-				defer client.Close()
+				client, err = dialRPCContext(ctx)
 			}
 
-			err = client.Call(request.Method, &input, &output)
 			if err != nil {
-				fmt.Println(err)
-				return
+				return nil, connectionError(err)
 			}
+			defer client.Close()
 
-			jsonOutput, err := json.MarshalIndent(output, "", "\t")
-			if err != nil {
-				fmt.Println(err)
-				return
+			if err = client.Call(ctx, request.Method, input, &output); err != nil {
+				return nil, callError(err)
 			}
-			fmt.Println("output :\n", string(jsonOutput))
-		},
+
+			return output, nil
+		}),
 	}
 
 	for _, param := range request.Params {
@@ -0,0 +1,77 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/seeleteam/go-seele/cmd/client/console"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/seeleclient"
+	"github.com/spf13/cobra"
+)
+
+var consoleScript string
+var consoleHistory string
+var consoleWS bool
+
+// consoleCmd represents the command to start the interactive console
+var consoleCmd = &cobra.Command{
+	Use:   "console",
+	Short: "start an interactive console to call the node apis",
+	Long: `start an interactive console connected to the node, which calls the api methods,
+  e.g. "seele.getbalance 0x<address>", with tab completion and command history.
+  Type "help" in console for the available commands. The commands in script are executed
+  line by line if --exec specified, and it stops at the first failed command.
+  For example:
+    client.exe console [-a 127.0.0.1:55027]
+    client.exe console --ws [-w ws://127.0.0.1:8080/ws]
+    client.exe console --exec runbook.txt`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		ctx, cancel := newContext()
+		defer cancel()
+
+		var client *seeleclient.Client
+		var err error
+		if consoleWS {
+			client, err = dialWebsocket(ctx)
+		} else {
+			client, err = dialRPCContext(ctx)
+		}
+
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		c, err := console.New(console.Config{Client: client, Timeout: timeout})
+		if err != nil {
+			return nil, callError(fmt.Errorf("discovering the api methods failed: %s", err.Error()))
+		}
+
+		if consoleScript == "" {
+			return nil, c.Interactive(consoleHistory)
+		}
+
+		script, err := os.Open(consoleScript)
+		if err != nil {
+			return nil, invalidInputError("%s", err.Error())
+		}
+		defer script.Close()
+
+		return nil, c.RunScript(script)
+	}),
+}
+
+func init() {
+	rootCmd.AddCommand(consoleCmd)
+
+	consoleCmd.Flags().StringVar(&consoleScript, "exec", "", "script file of commands to execute non-interactively")
+	consoleCmd.Flags().StringVar(&consoleHistory, "history", filepath.Join(common.GetDefaultDataFolder(), "console_history"), "file to persist the command history")
+	consoleCmd.Flags().BoolVar(&consoleWS, "ws", false, "connect the node via websocket address")
+}
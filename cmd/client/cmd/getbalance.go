@@ -7,10 +7,8 @@ package cmd
 
 import (
 	"fmt"
-	"math/big"
 
 	"github.com/seeleteam/go-seele/common"
-	"github.com/seeleteam/go-seele/rpc"
 	"github.com/spf13/cobra"
 )
 
@@ -18,45 +16,50 @@ var (
 	account *string
 )
 
+// balanceResult is the output of getbalance command, and the account is
+// empty for the coinbase balance.
+type balanceResult struct {
+	Account string `json:"account,omitempty"`
+	Balance string `json:"balance"`
+}
+
 // getbalanceCmd represents the getbalance command
 var getbalanceCmd = &cobra.Command{
 	Use:   "getbalance",
 	Short: "get the balance of an account",
 	Long: `For example:
 	client.exe getbalance`,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		var address common.Address
+		if account != nil && *account != "" {
+			var err error
+			if address, err = common.HexToAddress(*account); err != nil {
+				return nil, invalidInputError("invalid account address: %s", err.Error())
+			}
+		}
+
+		client, err := dialRPC()
 		if err != nil {
-			fmt.Println(err.Error())
-			return
+			return nil, connectionError(err)
 		}
 		defer client.Close()
 
-		var address *common.Address
-		if account == nil || *account == "" {
-			address = nil
-		} else {
-			result, err := common.HexToAddress(*account)
-			if err != nil {
-				fmt.Printf("invalid account address: %s\n", err.Error())
-				return
-			}
-
-			address = &result
-		}
+		ctx, cancel := newContext()
+		defer cancel()
 
-		amount := big.NewInt(0)
-		err = client.Call("seele.GetBalance", &address, amount)
+		amount, err := client.GetBalance(ctx, address)
 		if err != nil {
-			fmt.Printf("getting the balance failed: %s\n", err.Error())
+			return nil, callError(fmt.Errorf("getting the balance failed: %s", err.Error()))
 		}
 
-		if address == nil {
-			fmt.Printf("no account is provided. the coinbase balance: %s\n", common.BigToDecimal(amount))
-		} else {
-			fmt.Printf("Account: %s\nBalance: %s\n", address.ToHex(), common.BigToDecimal(amount))
+		// no account is provided, and the coinbase balance is returned
+		result := &balanceResult{Balance: common.BigToDecimal(amount)}
+		if !address.Equal(common.Address{}) {
+			result.Account = address.ToHex()
 		}
-	},
+
+		return result, nil
+	}),
 }
 
 func init() {
@@ -8,11 +8,16 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/seeleteam/go-seele/rpc"
-	"github.com/seeleteam/go-seele/seele"
 	"github.com/spf13/cobra"
 )
 
+// infoResult is the output of getinfo command
+type infoResult struct {
+	Coinbase           string `json:"coinbase"`
+	CurrentBlockHeight uint64 `json:"currentBlockHeight"`
+	HeaderHash         string `json:"headerHash"`
+}
+
 // getinfo represents the getinfo command
 var getinfo = &cobra.Command{
 	Use:   "getinfo",
@@ -20,24 +25,27 @@ var getinfo = &cobra.Command{
 	Long: `get the miner info
     For example:
 		client.exe getinfo -a 127.0.0.1:55027`,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
 		if err != nil {
-			fmt.Println(err.Error())
-			return
+			return nil, connectionError(err)
 		}
 		defer client.Close()
 
-		var info seele.MinerInfo
-		err = client.Call("seele.GetInfo", nil, &info)
+		ctx, cancel := newContext()
+		defer cancel()
+
+		info, err := client.GetInfo(ctx)
 		if err != nil {
-			fmt.Printf("getting the miner info failed: %s\n", err.Error())
+			return nil, callError(fmt.Errorf("getting the miner info failed: %s", err.Error()))
 		}
 
-		fmt.Printf("coinbase address: %s\n", info.Coinbase.ToHex())
-		fmt.Printf("current block height: %d\n", info.CurrentBlockHeight)
-		fmt.Printf("current block header hash: %s\n", info.HeaderHash.ToHex())
-	},
+		return &infoResult{
+			Coinbase:           info.Coinbase.ToHex(),
+			CurrentBlockHeight: info.CurrentBlockHeight,
+			HeaderHash:         info.HeaderHash.ToHex(),
+		}, nil
+	}),
 }
 
 func init() {
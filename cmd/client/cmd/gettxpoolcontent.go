@@ -6,10 +6,6 @@
 package cmd
 
 import (
-	"encoding/json"
-	"fmt"
-
-	"github.com/seeleteam/go-seele/rpc"
 	"github.com/spf13/cobra"
 )
 
@@ -19,28 +15,23 @@ var gettxpoolcontentCmd = &cobra.Command{
 	Short: "get content of the tx pool",
 	Long: `For example:
 	client.exe gettxpoolcontent`,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
 		if err != nil {
-			fmt.Println(err)
-			return
+			return nil, connectionError(err)
 		}
 		defer client.Close()
 
-		var result map[string][]map[string]interface{}
-		err = client.Call("debug.GetTxPoolContent", nil, &result)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
+		ctx, cancel := newContext()
+		defer cancel()
 
-		jsonResult, err := json.MarshalIndent(&result, "", "\t")
+		result, err := client.GetTxPoolContent(ctx)
 		if err != nil {
-			fmt.Println(err)
-			return
+			return nil, callError(err)
 		}
-		fmt.Println("tx pool content :\n", string(jsonResult))
-	},
+
+		return result, nil
+	}),
 }
 
 func init() {
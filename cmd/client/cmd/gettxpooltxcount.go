@@ -8,7 +8,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/seeleteam/go-seele/rpc"
 	"github.com/spf13/cobra"
 )
 
@@ -18,22 +17,23 @@ var gettxpooltxcountCmd = &cobra.Command{
 	Short: "get the number of all processable transactions contained within the transaction pool",
 	Long: `For example:
 	client.exe gettxpooltxcount`,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
 		if err != nil {
-			fmt.Println(err)
-			return
+			return nil, connectionError(err)
 		}
 		defer client.Close()
 
-		var status uint64
-		err = client.Call("debug.GetTxPoolTxCount", nil, &status)
+		ctx, cancel := newContext()
+		defer cancel()
 
+		count, err := client.GetTxPoolTxCount(ctx)
 		if err != nil {
-			fmt.Printf("get tx pool status failed %s\n", err.Error())
+			return nil, callError(fmt.Errorf("get tx pool status failed %s", err.Error()))
 		}
-		fmt.Printf("tx pool status : %d\n", status)
-	},
+
+		return count, nil
+	}),
 }
 
 func init() {
@@ -0,0 +1,74 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/spf13/cobra"
+)
+
+var keyShard uint
+var keyShort bool
+
+// keyPairResult is the output of key command
+type keyPairResult struct {
+	Address    string `json:"address,omitempty"` // short address, only if --short
+	PublicKey  string `json:"publicKey"`
+	PrivateKey string `json:"privateKey"`
+}
+
+// keyCmd represents the command to generate a key pair
+var keyCmd = &cobra.Command{
+	Use:   "key",
+	Short: "generate a key pair with specified shard number",
+	Long: `generate a key pair and print them with hex values
+  For example:
+    client.exe key --shard 1`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		if keyShard > common.ShardNumber {
+			return nil, invalidInputError("not supported shard number, shard number should be [0, %d]", common.ShardNumber)
+		}
+
+		var privateKey *ecdsa.PrivateKey
+		for {
+			publicKey, key, err := crypto.GenerateKeyPair()
+			if err != nil {
+				return nil, err
+			}
+
+			if keyShort {
+				publicKey = crypto.GetShortAddress(key)
+			}
+
+			if keyShard == 0 || common.GetShardNumber(*publicKey) == keyShard {
+				privateKey = key
+				break
+			}
+		}
+
+		result := &keyPairResult{
+			PublicKey:  crypto.MustGetAddress(privateKey).ToHex(),
+			PrivateKey: hexutil.BytesToHex(crypto.FromECDSA(privateKey)),
+		}
+
+		if keyShort {
+			result.Address = crypto.GetShortAddress(privateKey).ToHex()
+		}
+
+		return result, nil
+	}),
+}
+
+func init() {
+	rootCmd.AddCommand(keyCmd)
+
+	keyCmd.Flags().UintVar(&keyShard, "shard", 0, "shard number")
+	keyCmd.Flags().BoolVar(&keyShort, "short", false, "whether to use the short address derived from the hash of public key, which is only supported if enabled in chain config")
+}
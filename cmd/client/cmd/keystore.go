@@ -0,0 +1,100 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/spf13/cobra"
+)
+
+var keystoreFile string
+var keystoreKDF string
+var keystoreLight bool
+
+// keystoreResult is the output of keystore commands
+type keystoreResult struct {
+	Address string `json:"address"`
+	File    string `json:"file"`
+	Version int    `json:"version"`
+	KDF     string `json:"kdf,omitempty"`
+}
+
+// keystoreCmd represents the command to manage the key files
+var keystoreCmd = &cobra.Command{
+	Use:   "keystore",
+	Short: "manage the encrypted key files",
+	Long: `manage the encrypted key files, e.g. re-encrypt the legacy key files in the current
+  version with the KDF of standard or light strength, or change the password.
+  For example:
+    client.exe keystore upgrade --file .keystore [--kdf scrypt|pbkdf2] [--light]
+    client.exe keystore changepassword --file .keystore`,
+}
+
+// keystoreUpgradeCmd represents the command to upgrade key file
+var keystoreUpgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "re-encrypt the key file in the current version with the specified KDF",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		params, err := keystore.GetParams(keystoreKDF, keystoreLight)
+		if err != nil {
+			return nil, invalidInputError("%s", err.Error())
+		}
+
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password err %s", err.Error())
+		}
+
+		key, err := keystore.Upgrade(keystoreFile, pass, params)
+		if err != nil {
+			return nil, err
+		}
+
+		return &keystoreResult{key.Address.ToHex(), keystoreFile, keystore.Version, params.KDF}, nil
+	}),
+}
+
+// keystoreChangePasswordCmd represents the command to change the password of key file
+var keystoreChangePasswordCmd = &cobra.Command{
+	Use:   "changepassword",
+	Short: "change the password of the key file",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password err %s", err.Error())
+		}
+
+		fmt.Fprintln(os.Stderr, "Please input the new password.")
+		newPass, err := common.SetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password err %s", err.Error())
+		}
+
+		key, err := keystore.ChangePassword(keystoreFile, pass, newPass)
+		if err != nil {
+			return nil, err
+		}
+
+		return &keystoreResult{Address: key.Address.ToHex(), File: keystoreFile, Version: keystore.Version}, nil
+	}),
+}
+
+func init() {
+	rootCmd.AddCommand(keystoreCmd)
+	keystoreCmd.AddCommand(keystoreUpgradeCmd, keystoreChangePasswordCmd)
+
+	for _, cmd := range []*cobra.Command{keystoreUpgradeCmd, keystoreChangePasswordCmd} {
+		cmd.Flags().StringVarP(&keystoreFile, "file", "f", "", "key file")
+		cmd.MarkFlagRequired("file")
+	}
+
+	keystoreUpgradeCmd.Flags().StringVar(&keystoreKDF, "kdf", keystore.KDFScrypt, "KDF to derive the encryption key from password, scrypt or pbkdf2")
+	keystoreUpgradeCmd.Flags().BoolVar(&keystoreLight, "light", false, "whether to use the KDF of light strength, which is faster but less secure")
+}
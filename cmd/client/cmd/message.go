@@ -0,0 +1,144 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/seeleteam/go-seele/accounts/signer"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/seeleteam/go-seele/seele"
+	"github.com/spf13/cobra"
+)
+
+var messageSigner string
+var messageText string
+var messageAddress string
+var messageSignature string
+
+// messageResult is the output of signmessage and verifymessage commands
+type messageResult struct {
+	Address   string `json:"address"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+	Valid     *bool  `json:"valid,omitempty"`
+}
+
+// signmessageCmd represents the command to sign personal message
+var signmessageCmd = &cobra.Command{
+	Use:   "signmessage",
+	Short: "sign a message to prove the control of address",
+	Long: `sign a personal message with the key file, or the account in node keystore, and the
+  signature could be verified with verifymessage to prove the control of address.
+  For example:
+    client.exe signmessage -f keyfile -m "message"
+    client.exe signmessage -f 0x<unlocked account address> -m "message"
+    client.exe signmessage -f 0x<account address> -m "message" --signer 127.0.0.1:55030`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		message := []byte(messageText)
+
+		// signed in external signer or in node with the unlocked account if the signer is not a key file
+		if address, err := common.HexToAddress(messageSigner); err == nil && !common.FileOrFolderExists(messageSigner) {
+			if len(signerAddr) > 0 {
+				sig, err := signer.NewClient(signerAddr, 0).SignMessage(address, message)
+				if err != nil {
+					return nil, callError(err)
+				}
+
+				return &messageResult{Address: address.ToHex(), Message: messageText, Signature: hexutil.BytesToHex(sig.Bytes())}, nil
+			}
+
+			client, err := dialRPC()
+			if err != nil {
+				return nil, connectionError(err)
+			}
+			defer client.Close()
+
+			ctx, cancel := newContext()
+			defer cancel()
+
+			sig, err := client.Sign(ctx, seele.SignRequest{Address: address, Data: hexutil.BytesToHex(message)})
+			if err != nil {
+				return nil, callError(err)
+			}
+
+			return &messageResult{Address: address.ToHex(), Message: messageText, Signature: sig}, nil
+		}
+
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password failed %s", err.Error())
+		}
+
+		key, err := keystore.GetKey(messageSigner, pass)
+		if err != nil {
+			return nil, invalidInputError("invalid key file. it should be a private key: %s", err.Error())
+		}
+
+		sig := crypto.SignMessage(key.PrivateKey, message)
+
+		return &messageResult{
+			Address:   key.Address.ToHex(),
+			Message:   messageText,
+			Signature: hexutil.BytesToHex(sig.Bytes()),
+		}, nil
+	}),
+}
+
+// verifymessageCmd represents the command to verify the signature of personal message
+var verifymessageCmd = &cobra.Command{
+	Use:   "verifymessage",
+	Short: "verify the signature of message signed by the address",
+	Long: `verify the signature of personal message signed by the address offline
+  For example:
+    client.exe verifymessage --address 0x<address> -m "message" --signature 0x<signature>`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		address, err := common.HexToAddress(messageAddress)
+		if err != nil {
+			return nil, invalidInputError("invalid address: %s", err.Error())
+		}
+
+		sigBytes, err := hexutil.HexToBytes(messageSignature)
+		if err != nil {
+			return nil, invalidInputError("invalid signature: %s", err.Error())
+		}
+
+		sig, err := crypto.BytesToSignature(sigBytes)
+		if err != nil {
+			return nil, invalidInputError("invalid signature: %s", err.Error())
+		}
+
+		valid := crypto.VerifyMessage(&address, []byte(messageText), sig)
+
+		return &messageResult{
+			Address:   address.ToHex(),
+			Message:   messageText,
+			Signature: messageSignature,
+			Valid:     &valid,
+		}, nil
+	}),
+}
+
+func init() {
+	rootCmd.AddCommand(signmessageCmd, verifymessageCmd)
+
+	for _, cmd := range []*cobra.Command{signmessageCmd, verifymessageCmd} {
+		cmd.Flags().StringVarP(&messageText, "message", "m", "", "message to sign")
+		cmd.MarkFlagRequired("message")
+	}
+
+	signmessageCmd.Flags().StringVarP(&messageSigner, "from", "f", "", "key file path of the signer, or address of the unlocked account in node keystore or the account in external signer")
+	signmessageCmd.MarkFlagRequired("from")
+
+	verifymessageCmd.Flags().StringVar(&messageAddress, "address", "", "address of the signer")
+	verifymessageCmd.MarkFlagRequired("address")
+
+	verifymessageCmd.Flags().StringVar(&messageSignature, "signature", "", "signature in HEX")
+	verifymessageCmd.MarkFlagRequired("signature")
+}
@@ -0,0 +1,206 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/seeleteam/go-seele/rpc"
+	"github.com/seeleteam/go-seele/seeleclient"
+	"github.com/spf13/cobra"
+)
+
+// paramsFlag is the flag of method params in JSON, which overrides other params.
+const paramsFlag = "json"
+
+var errInvalidParams = errors.New("invalid params in JSON")
+
+var callParams string
+
+// modulesCmd represents the command to list the API modules
+var modulesCmd = &cobra.Command{
+	Use:   "modules",
+	Short: "list the api modules served by the node",
+	Long: `list the api modules and versions, and the methods of modules could be called with
+  command "call <module>.<method>", e.g. "client call seele.getblockheight".
+  For example:
+    client.exe modules [-a 127.0.0.1:55027]`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		modules, err := client.Modules(ctx)
+		if err != nil {
+			return nil, callError(err)
+		}
+
+		return modules, nil
+	}),
+}
+
+// callCmd represents the command to call the api method discovered from the node
+var callCmd = &cobra.Command{
+	Use:   "call <module>.<method> [<value> | <field>=<value>...]",
+	Short: "call the api method served by the node",
+	Long: `call the api method served by the node, which is discovered from the node by the name
+  "<module>.<method>" in case insensitive. The param of basic type is specified with the value,
+  and the fields of struct param are specified with <field>=<value>, in which the value of
+  non-basic type is in JSON. Otherwise, the params could be specified in JSON with flag --json.
+  For example:
+    client.exe call seele.getblockheight
+    client.exe call seele.getblockbyheight height=1 fulltx=true
+    client.exe call seele.getblockbyheight --json {"height":1,"fullTx":true}`,
+	Args: cobra.MinimumNArgs(1),
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		serviceMethod, method, err := findMethod(ctx, client, args[0])
+		if err != nil {
+			return nil, err
+		}
+
+		var input interface{}
+		if cmd.Flags().Changed(paramsFlag) {
+			if !json.Valid([]byte(callParams)) {
+				return nil, invalidInputError("%s", errInvalidParams.Error())
+			}
+
+			input = json.RawMessage(callParams)
+		} else if input, err = buildParams(method.Params, args[1:]); err != nil {
+			return nil, invalidInputError("%s", err.Error())
+		}
+
+		var output interface{}
+		if err = client.Call(ctx, serviceMethod, input, &output); err != nil {
+			return nil, callError(err)
+		}
+
+		return output, nil
+	}),
+}
+
+func init() {
+	rootCmd.AddCommand(modulesCmd)
+
+	callCmd.Flags().StringVar(&callParams, paramsFlag, "", "params in JSON, which overrides the other params")
+	rootCmd.AddCommand(callCmd)
+}
+
+// findMethod discovers the method of the specified name "<module>.<method>" in case insensitive
+// from the node, and returns the service method name to call with the method description.
+func findMethod(ctx context.Context, client *seeleclient.Client, name string) (string, *rpc.MethodDescription, error) {
+	dot := strings.Index(name, ".")
+	if dot <= 0 {
+		return "", nil, invalidInputError("invalid method %s, should be <module>.<method>", name)
+	}
+
+	modules, err := client.Describe(ctx, "")
+	if err != nil {
+		return "", nil, callError(err)
+	}
+
+	for _, module := range modules {
+		if !strings.EqualFold(module.Namespace, name[:dot]) {
+			continue
+		}
+
+		for _, method := range module.Methods {
+			if strings.EqualFold(method.Name, name[dot+1:]) {
+				return module.Namespace + "." + method.Name, method, nil
+			}
+		}
+	}
+
+	return "", nil, invalidInputError("method %s not found", name)
+}
+
+// buildParams builds the method params from the args. The arg is the value of basic type
+// params, or in the form of <field>=<value> for struct params.
+func buildParams(schema *rpc.TypeSchema, args []string) (interface{}, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	if len(schema.Fields) == 0 {
+		if len(args) > 1 {
+			return nil, fmt.Errorf("too many params, want 1 got %d", len(args))
+		}
+
+		return parseParamValue(args[0], schema.Type)
+	}
+
+	fields := make(map[string]interface{})
+	for _, arg := range args {
+		eq := strings.Index(arg, "=")
+		if eq <= 0 {
+			return nil, fmt.Errorf("invalid param %s, should be <field>=<value>", arg)
+		}
+
+		field := findField(schema, arg[:eq])
+		if field == nil {
+			return nil, fmt.Errorf("unknown param field %s", arg[:eq])
+		}
+
+		value, err := parseParamValue(arg[eq+1:], field.Schema.Type)
+		if err != nil {
+			return nil, fmt.Errorf("invalid param field %s, %s", field.Name, err)
+		}
+
+		fields[field.Name] = value
+	}
+
+	return fields, nil
+}
+
+// findField returns the field of the struct schema by name in case insensitive, or nil if not found.
+func findField(schema *rpc.TypeSchema, name string) *rpc.FieldSchema {
+	for _, field := range schema.Fields {
+		if strings.EqualFold(field.Name, name) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// parseParamValue parses the param value of the specified JSON type.
+// The value of non-basic type, e.g. array or object, should be in JSON.
+func parseParamValue(value string, jsonType string) (interface{}, error) {
+	switch jsonType {
+	case "string":
+		return value, nil
+	case "integer":
+		return strconv.ParseInt(value, 10, 64)
+	case "number":
+		return strconv.ParseFloat(value, 64)
+	case "boolean":
+		return strconv.ParseBool(value)
+	default:
+		if !json.Valid([]byte(value)) {
+			return nil, errInvalidParams
+		}
+
+		return json.RawMessage(value), nil
+	}
+}
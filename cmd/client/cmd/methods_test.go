@@ -0,0 +1,56 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+func Test_BuildParams(t *testing.T) {
+	// basic type param
+	schema := &rpc.TypeSchema{Type: "integer"}
+	params, err := buildParams(schema, []string{"38"})
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, params, int64(38))
+
+	params, err = buildParams(schema, nil)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, params, nil)
+
+	_, err = buildParams(schema, []string{"1", "2"})
+	assert.Equal(t, err != nil, true)
+
+	// struct param
+	schema = &rpc.TypeSchema{Type: "object", Fields: []*rpc.FieldSchema{
+		{Name: "Height", Schema: &rpc.TypeSchema{Type: "integer"}},
+		{Name: "FullTx", Schema: &rpc.TypeSchema{Type: "boolean"}},
+		{Name: "Hashes", Schema: &rpc.TypeSchema{Type: "array"}},
+	}}
+
+	params, err = buildParams(schema, []string{"height=1", "fulltx=true", `hashes=["a"]`})
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, params, map[string]interface{}{
+		"Height": int64(1),
+		"FullTx": true,
+		"Hashes": json.RawMessage(`["a"]`),
+	})
+
+	_, err = buildParams(schema, []string{"height"})
+	assert.Equal(t, err != nil, true)
+
+	_, err = buildParams(schema, []string{"nonce=1"})
+	assert.Equal(t, err != nil, true)
+
+	_, err = buildParams(schema, []string{"height=a"})
+	assert.Equal(t, err != nil, true)
+
+	_, err = buildParams(schema, []string{"hashes=[a"})
+	assert.Equal(t, err != nil, true)
+}
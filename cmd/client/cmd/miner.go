@@ -8,7 +8,6 @@ package cmd
 import (
 	"fmt"
 
-	"github.com/seeleteam/go-seele/rpc"
 	"github.com/spf13/cobra"
 )
 
@@ -17,7 +16,12 @@ var start bool
 var stop bool
 var gethashrate bool
 
-// getbalanceCmd represents the getbalance command
+// minerStatus is the output of miner command to start or stop miner
+type minerStatus struct {
+	Status string `json:"status"`
+}
+
+// minerCmd represents the miner command
 var minerCmd = &cobra.Command{
 	Use:   "miner",
 	Short: "miner actions",
@@ -25,38 +29,43 @@ var minerCmd = &cobra.Command{
 	 client.exe miner --start [-t <miner threads num>]
 	 client.exe miner --stop
 	 client.exe miner --gethashrate`,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		if !start && !stop && !gethashrate {
+			return nil, invalidInputError("command param is not defined.")
+		}
+
+		client, err := dialRPC()
 		if err != nil {
-			fmt.Println(err)
-			return
+			return nil, connectionError(err)
 		}
 		defer client.Close()
 
-		var result string
-		var input string
+		ctx, cancel := newContext()
+		defer cancel()
+
 		if start {
-			err = client.Call("miner.Start", &threadsNum, &result)
-			if err != nil {
-				fmt.Printf("miner start failed: %s\n", err.Error())
-				return
+			if err = client.StartMiner(ctx, *threadsNum); err != nil {
+				return nil, callError(fmt.Errorf("miner start failed: %s", err.Error()))
 			}
-			fmt.Println("miner start succeed")
-		} else if stop {
-			err = client.Call("miner.Stop", &input, &result)
-			if err != nil {
-				fmt.Printf("miner stop failed: %s\n", err.Error())
-				return
+
+			return &minerStatus{"started"}, nil
+		}
+
+		if stop {
+			if err = client.StopMiner(ctx); err != nil {
+				return nil, callError(fmt.Errorf("miner stop failed: %s", err.Error()))
 			}
-			fmt.Println("miner stop succeed")
-		} else if gethashrate {
-			var hashrate uint64
-			client.Call("miner.Hashrate", &input, &hashrate)
-			fmt.Printf("miner hashrate is: %d\n", hashrate)
-		} else {
-			fmt.Println("command param is not defined.")
+
+			return &minerStatus{"stopped"}, nil
+		}
+
+		hashrate, err := client.Hashrate(ctx)
+		if err != nil {
+			return nil, callError(fmt.Errorf("getting the miner hashrate failed: %s", err.Error()))
 		}
-	},
+
+		return hashrate, nil
+	}),
 }
 
 func init() {
@@ -0,0 +1,314 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/spf13/cobra"
+)
+
+var multiSigFrom string
+var multiSigTo string
+var multiSigAmount string
+var multiSigFee string
+var multiSigOwners []string
+var multiSigThreshold uint64
+var multiSigAccount string
+var multiSigNonce uint64
+var multiSigTxFile string
+var multiSigKeyFile string
+
+// multiSigResult is the output of multisig create and info commands
+type multiSigResult struct {
+	TxHash    string   `json:"txHash,omitempty"`
+	Address   string   `json:"address"`
+	Threshold uint64   `json:"threshold"`
+	Owners    []string `json:"owners"`
+}
+
+// multiSigTxResult is the output of multisig newtx and sign commands
+type multiSigTxResult struct {
+	sendTxResult
+	Signatures int    `json:"signatures"`
+	File       string `json:"file"`
+}
+
+// multisigCmd represents the command to manage the multisig accounts
+var multisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "manage the M-of-N multisig accounts",
+	Long: `manage the multisig accounts, which require the signatures of at least threshold
+  owners to spend. The tx of multisig account is created offline in file, co-signed by the
+  owners one by one, and then sent with sendrawtx.
+  For example:
+    client.exe multisig create -f keyfile --owners 0x<owner1>,0x<owner2>,0x<owner3> --threshold 2 -m 100 --fee 1
+    client.exe multisig info --account 0x<multisig address>
+    client.exe multisig newtx --account 0x<multisig address> -t 0x<public address> -m 10 --fee 1 --txfile multisig.tx
+    client.exe multisig sign -f owner1.keyfile --txfile multisig.tx
+    client.exe multisig sign -f owner2.keyfile --txfile multisig.tx
+    client.exe sendrawtx --txfile multisig.tx`,
+}
+
+// multisigCreateCmd represents the command to create a multisig account
+var multisigCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "create a multisig account with the owners and threshold",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		var owners []common.Address
+		for _, owner := range multiSigOwners {
+			addr, err := common.HexToAddress(owner)
+			if err != nil {
+				return nil, invalidInputError("invalid owner address %s: %s", owner, err.Error())
+			}
+
+			owners = append(owners, addr)
+		}
+
+		amount, fee, err := parseAmountAndFee(multiSigAmount, multiSigFee)
+		if err != nil {
+			return nil, err
+		}
+
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password failed %s", err.Error())
+		}
+
+		key, err := keystore.GetKey(multiSigFrom, pass)
+		if err != nil {
+			return nil, invalidInputError("invalid sender key file. it should be a private key: %s", err.Error())
+		}
+
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		nonce, err := client.GetAccountNonce(ctx, key.Address)
+		if err != nil {
+			return nil, callError(err)
+		}
+
+		tx, err := types.NewMultiSigTransaction(key.Address, owners, multiSigThreshold, amount, fee, nonce)
+		if err != nil {
+			return nil, invalidInputError("%s", err.Error())
+		}
+
+		tx.Sign(key.PrivateKey)
+
+		if err = client.AddTx(ctx, tx); err != nil {
+			return nil, callError(fmt.Errorf("adding the tx failed: %s", err.Error()))
+		}
+
+		address := crypto.CreateAddress(key.Address, nonce)
+		return newMultiSigResult(tx.Hash.ToHex(), address, &types.MultiSigAccount{Threshold: multiSigThreshold, Owners: owners}), nil
+	}),
+}
+
+// multisigInfoCmd represents the command to get the owners and threshold of multisig account
+var multisigInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "get the owners and threshold of the multisig account",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		address, err := common.HexToAddress(multiSigAccount)
+		if err != nil {
+			return nil, invalidInputError("invalid multisig account address: %s", err.Error())
+		}
+
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		multiSig, err := client.GetMultiSigAccount(ctx, address)
+		if err != nil {
+			return nil, callError(err)
+		}
+
+		return newMultiSigResult("", address, multiSig), nil
+	}),
+}
+
+// multisigNewTxCmd represents the command to create an unsigned tx of multisig account
+var multisigNewTxCmd = &cobra.Command{
+	Use:   "newtx",
+	Short: "create an unsigned tx of the multisig account and save it to file",
+	Long: `create an unsigned tx of the multisig account and save it in RLP HEX to file, which
+  should be co-signed by the owners. The account nonce is retrieved from the node if not
+  specified.`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		from, err := common.HexToAddress(multiSigAccount)
+		if err != nil {
+			return nil, invalidInputError("invalid multisig account address: %s", err.Error())
+		}
+
+		to, err := common.HexToAddress(multiSigTo)
+		if err != nil {
+			return nil, invalidInputError("invalid receiver address: %s", err.Error())
+		}
+
+		amount, fee, err := parseAmountAndFee(multiSigAmount, multiSigFee)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := multiSigNonce
+		if !cmd.Flags().Changed("nonce") {
+			client, err := dialRPC()
+			if err != nil {
+				return nil, connectionError(err)
+			}
+			defer client.Close()
+
+			ctx, cancel := newContext()
+			defer cancel()
+
+			if nonce, err = client.GetAccountNonce(ctx, from); err != nil {
+				return nil, callError(err)
+			}
+		}
+
+		tx, err := types.NewTransaction(from, to, amount, fee, nonce)
+		if err != nil {
+			return nil, invalidInputError("%s", err.Error())
+		}
+
+		if err = writeTxFile(multiSigTxFile, tx); err != nil {
+			return nil, err
+		}
+
+		return newMultiSigTxResult(tx), nil
+	}),
+}
+
+// multisigSignCmd represents the command to co-sign the tx of multisig account
+var multisigSignCmd = &cobra.Command{
+	Use:   "sign",
+	Short: "co-sign the tx of multisig account in file with the owner key",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		tx, err := readTxFile(multiSigTxFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password failed %s", err.Error())
+		}
+
+		key, err := keystore.GetKey(multiSigKeyFile, pass)
+		if err != nil {
+			return nil, invalidInputError("invalid owner key file. it should be a private key: %s", err.Error())
+		}
+
+		if err = tx.CoSign(key.PrivateKey); err != nil {
+			return nil, invalidInputError("invalid tx file: %s", err.Error())
+		}
+
+		if err = writeTxFile(multiSigTxFile, tx); err != nil {
+			return nil, err
+		}
+
+		return newMultiSigTxResult(tx), nil
+	}),
+}
+
+// parseAmountAndFee parses the non-negative amount and fee of the tx.
+func parseAmountAndFee(amountValue, feeValue string) (*big.Int, *big.Int, error) {
+	amount, ok := big.NewInt(0).SetString(amountValue, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, nil, invalidInputError("invalid amount value")
+	}
+
+	fee, ok := big.NewInt(0).SetString(feeValue, 10)
+	if !ok || fee.Sign() < 0 {
+		return nil, nil, invalidInputError("invalid fee value")
+	}
+
+	return amount, fee, nil
+}
+
+func newMultiSigResult(txHash string, address common.Address, multiSig *types.MultiSigAccount) *multiSigResult {
+	result := &multiSigResult{
+		TxHash:    txHash,
+		Address:   address.ToHex(),
+		Threshold: multiSig.Threshold,
+	}
+
+	for _, owner := range multiSig.Owners {
+		result.Owners = append(result.Owners, owner.ToHex())
+	}
+
+	return result
+}
+
+func newMultiSigTxResult(tx *types.Transaction) *multiSigTxResult {
+	return &multiSigTxResult{
+		sendTxResult: sendTxResult{
+			TxHash:       tx.Hash.ToHex(),
+			From:         tx.Data.From.ToHex(),
+			To:           tx.Data.To.ToHex(),
+			Amount:       tx.Data.Amount,
+			Fee:          tx.Data.Fee,
+			AccountNonce: &tx.Data.AccountNonce,
+		},
+		Signatures: len(tx.Signatures),
+		File:       multiSigTxFile,
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(multisigCmd)
+	multisigCmd.AddCommand(multisigCreateCmd, multisigInfoCmd, multisigNewTxCmd, multisigSignCmd)
+
+	multisigCreateCmd.Flags().StringVarP(&multiSigFrom, "from", "f", "", "key file path of the creator")
+	multisigCreateCmd.MarkFlagRequired("from")
+
+	multisigCreateCmd.Flags().StringSliceVar(&multiSigOwners, "owners", nil, "comma separated addresses of the owners")
+	multisigCreateCmd.MarkFlagRequired("owners")
+
+	multisigCreateCmd.Flags().Uint64Var(&multiSigThreshold, "threshold", 0, "number of owner signatures required to spend")
+	multisigCreateCmd.MarkFlagRequired("threshold")
+
+	multisigNewTxCmd.Flags().StringVarP(&multiSigTo, "to", "t", "", "public address of the receiver")
+	multisigNewTxCmd.MarkFlagRequired("to")
+
+	multisigNewTxCmd.Flags().Uint64Var(&multiSigNonce, "nonce", 0, "account nonce of the multisig account, retrieved from the node if not specified")
+
+	for _, cmd := range []*cobra.Command{multisigCreateCmd, multisigNewTxCmd} {
+		cmd.Flags().StringVarP(&multiSigAmount, "amount", "m", "", "the amount of the transferred coins")
+		cmd.MarkFlagRequired("amount")
+
+		cmd.Flags().StringVar(&multiSigFee, "fee", "", "transaction fee")
+		cmd.MarkFlagRequired("fee")
+	}
+
+	for _, cmd := range []*cobra.Command{multisigInfoCmd, multisigNewTxCmd} {
+		cmd.Flags().StringVar(&multiSigAccount, "account", "", "address of the multisig account")
+		cmd.MarkFlagRequired("account")
+	}
+
+	for _, cmd := range []*cobra.Command{multisigNewTxCmd, multisigSignCmd} {
+		cmd.Flags().StringVar(&multiSigTxFile, "txfile", "multisig.tx", "file of the multisig tx")
+	}
+
+	multisigSignCmd.Flags().StringVarP(&multiSigKeyFile, "file", "f", "", "key file path of the owner")
+	multisigSignCmd.MarkFlagRequired("file")
+}
@@ -0,0 +1,254 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// output formats of the command results
+const (
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+	outputTable = "table"
+)
+
+// exit codes of the commands on failure
+const (
+	exitCodeFailure      = 1 // general failure
+	exitCodeInvalidInput = 2 // invalid flags or arguments
+	exitCodeConnection   = 3 // failed to connect to the node
+	exitCodeCall         = 4 // the rpc call failed
+)
+
+var outputFormat string
+
+// commandError is the error of command with the exit code.
+type commandError struct {
+	code int
+	err  error
+}
+
+func (e *commandError) Error() string {
+	return e.err.Error()
+}
+
+// invalidInputError returns the error of invalid flags or arguments.
+func invalidInputError(format string, args ...interface{}) error {
+	return &commandError{exitCodeInvalidInput, fmt.Errorf(format, args...)}
+}
+
+// connectionError returns the error of failure to connect to the node.
+func connectionError(err error) error {
+	return &commandError{exitCodeConnection, err}
+}
+
+// callError returns the error of failed rpc call.
+func callError(err error) error {
+	return &commandError{exitCodeCall, err}
+}
+
+// runWithOutput returns the run function of command, which prints the result of fn
+// in the output format, or prints the error to stderr and exits with the exit code.
+func runWithOutput(fn func(cmd *cobra.Command, args []string) (interface{}, error)) func(cmd *cobra.Command, args []string) {
+	return func(cmd *cobra.Command, args []string) {
+		result, err := fn(cmd, args)
+		if err == nil {
+			err = printResult(os.Stdout, result)
+		}
+
+		if err != nil {
+			exitWithError(err)
+		}
+	}
+}
+
+// exitWithError prints the error to stderr and exits with the exit code of error.
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+
+	if cmdErr, ok := err.(*commandError); ok {
+		os.Exit(cmdErr.code)
+	}
+
+	os.Exit(exitCodeFailure)
+}
+
+// printResult prints the result in the output format. Nothing is printed if nil.
+func printResult(w io.Writer, result interface{}) error {
+	if result == nil {
+		return nil
+	}
+
+	switch outputFormat {
+	case outputJSON:
+		encoded, err := json.MarshalIndent(result, "", "\t")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(w, string(encoded))
+		return err
+	case outputYAML:
+		value, err := toGeneric(result)
+		if err != nil {
+			return err
+		}
+
+		encoded, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(encoded)
+		return err
+	case outputTable:
+		value, err := toGeneric(result)
+		if err != nil {
+			return err
+		}
+
+		return printTable(w, value)
+	default:
+		return invalidInputError("invalid output format %s, should be %s, %s or %s", outputFormat, outputJSON, outputYAML, outputTable)
+	}
+}
+
+// toGeneric converts the value to the generic value decoded from JSON, so that the
+// JSON field names are used in all formats. The numbers are converted to integers
+// if possible, or strings if too large.
+func toGeneric(value interface{}) (interface{}, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err = decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	return convertNumbers(generic), nil
+}
+
+func convertNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		if strings.ContainsAny(v.String(), ".eE") {
+			if f, err := v.Float64(); err == nil {
+				return f
+			}
+		}
+
+		return v.String()
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = convertNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = convertNumbers(item)
+		}
+	}
+
+	return value
+}
+
+// printTable prints the object as key-value rows, the array of objects as rows with
+// columns of the object keys, and others line by line. The nested values are in JSON.
+func printTable(w io.Writer, value interface{}) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		fmt.Fprintln(tw, "KEY\tVALUE")
+		for _, key := range sortedKeys(v) {
+			fmt.Fprintf(tw, "%s\t%s\n", key, cellText(v[key]))
+		}
+	case []interface{}:
+		columns := tableColumns(v)
+		if len(columns) == 0 {
+			for _, item := range v {
+				fmt.Fprintln(tw, cellText(item))
+			}
+			break
+		}
+
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(columns, "\t")))
+		for _, item := range v {
+			row := item.(map[string]interface{})
+			cells := make([]string, len(columns))
+			for i, column := range columns {
+				cells[i] = cellText(row[column])
+			}
+
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	default:
+		fmt.Fprintln(tw, cellText(v))
+	}
+
+	return tw.Flush()
+}
+
+// tableColumns returns the sorted keys of all items if the items are all objects.
+func tableColumns(items []interface{}) []string {
+	keys := make(map[string]interface{})
+	for _, item := range items {
+		row, ok := item.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		for key := range row {
+			keys[key] = nil
+		}
+	}
+
+	return sortedKeys(keys)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// cellText returns the text of a table cell, and the nested value is in JSON.
+func cellText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,92 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func printResultIn(t *testing.T, format string, result interface{}) string {
+	outputFormat = format
+	defer func() { outputFormat = outputJSON }()
+
+	var buf bytes.Buffer
+	if err := printResult(&buf, result); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func Test_PrintResult(t *testing.T) {
+	nonce := uint64(5)
+	result := &sendTxResult{
+		TxHash:       "0x01",
+		From:         "0x02",
+		To:           "0x03",
+		Amount:       new(big.Int).Lsh(big.NewInt(1), 64),
+		Fee:          big.NewInt(1),
+		AccountNonce: &nonce,
+	}
+
+	assert.Equal(t, printResultIn(t, outputJSON, result), `{
+	"txHash": "0x01",
+	"from": "0x02",
+	"to": "0x03",
+	"amount": 18446744073709551616,
+	"fee": 1,
+	"accountNonce": 5
+}
+`)
+
+	// the integer too large is in string
+	assert.Equal(t, printResultIn(t, outputYAML, result), `accountNonce: 5
+amount: "18446744073709551616"
+fee: 1
+from: "0x02"
+to: "0x03"
+txHash: "0x01"
+`)
+
+	assert.Equal(t, printResultIn(t, outputTable, result), `KEY           VALUE
+accountNonce  5
+amount        18446744073709551616
+fee           1
+from          0x02
+to            0x03
+txHash        0x01
+`)
+
+	// nothing printed for nil result
+	assert.Equal(t, printResultIn(t, outputTable, nil), "")
+}
+
+func Test_PrintResult_Table(t *testing.T) {
+	peers := []map[string]interface{}{
+		{"id": "a", "caps": []string{"seele/1"}},
+		{"id": "b", "network": map[string]interface{}{"remote": "1.2.3.4"}},
+	}
+
+	// the empty cells are padded
+	assert.Equal(t, printResultIn(t, outputTable, peers), "CAPS         ID  NETWORK\n"+
+		"[\"seele/1\"]  a   \n"+
+		"             b   {\"remote\":\"1.2.3.4\"}\n")
+
+	assert.Equal(t, printResultIn(t, outputTable, []uint64{1, 2}), "1\n2\n")
+	assert.Equal(t, printResultIn(t, outputTable, "rlp"), "rlp\n")
+}
+
+func Test_PrintResult_InvalidFormat(t *testing.T) {
+	outputFormat = "xml"
+	defer func() { outputFormat = outputJSON }()
+
+	err := printResult(&bytes.Buffer{}, 1)
+	assert.Equal(t, err.(*commandError).code, exitCodeInvalidInput)
+}
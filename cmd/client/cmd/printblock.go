@@ -6,9 +6,6 @@
 package cmd
 
 import (
-	"fmt"
-
-	"github.com/seeleteam/go-seele/rpc"
 	"github.com/spf13/cobra"
 )
 
@@ -20,23 +17,23 @@ var printblockCmd = &cobra.Command{
 	Short: "get block pretty printed form by block height",
 	Long: `For example:
 	client.exe printblock --height -1 [-a 127.0.0.1:55027]`,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		client, err := dialRPC()
 		if err != nil {
-			fmt.Println(err)
-			return
+			return nil, connectionError(err)
 		}
 		defer client.Close()
 
-		var result string
-		err = client.Call("debug.PrintBlock", &heightPrint, &result)
+		ctx, cancel := newContext()
+		defer cancel()
+
+		result, err := client.PrintBlock(ctx, *heightPrint)
 		if err != nil {
-			fmt.Println(err)
-			return
+			return nil, callError(err)
 		}
 
-		fmt.Printf("block rlp : %s\n", result)
-	},
+		return result, nil
+	}),
 }
 
 func init() {
@@ -6,33 +6,58 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
-	comm "github.com/seeleteam/go-seele/cmd/comm"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/node"
+	"github.com/seeleteam/go-seele/rpc"
+	"github.com/seeleteam/go-seele/seeleclient"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var rpcAddr string
 var wsAddr string
+var ipcPath string
+var secretFile string
+var timeout time.Duration
+var signerAddr string
 
 // rootCmd represents the base command called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "client",
 	Short: "rpc client",
 	Long:  `rpc client to interact with node process`,
+	// the errors are printed to stderr with exit codes in Execute
+	SilenceErrors: true,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
 	//	Run: func(cmd *cobra.Command, args []string) { },
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		switch outputFormat {
+		case outputJSON, outputYAML, outputTable:
+			return nil
+		default:
+			return invalidInputError("invalid output format %s, should be %s, %s or %s", outputFormat, outputJSON, outputYAML, outputTable)
+		}
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
+	// the errors returned by cobra are caused by invalid flags or arguments
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Println(err.Error())
-		os.Exit(1)
+		if _, ok := err.(*commandError); !ok {
+			err = &commandError{exitCodeInvalidInput, err}
+		}
+
+		exitWithError(err)
 	}
 }
 
@@ -40,7 +65,11 @@ func init() {
 	cobra.OnInitialize(initConfig)
 	rootCmd.PersistentFlags().StringVarP(&rpcAddr, "addr", "a", "127.0.0.1:55027", "rpc address")
 	rootCmd.PersistentFlags().StringVarP(&wsAddr, "wsaddr", "w", "ws://127.0.0.1:8080/ws", "websocket rpc address")
-	rootCmd.AddCommand(comm.GetGenerateKeyPairCmd("client"))
+	rootCmd.PersistentFlags().StringVarP(&ipcPath, "ipcpath", "i", node.IPCEndpoint(defaultDataDir()), "ipc endpoint path, used by default if exists unless rpc address specified")
+	rootCmd.PersistentFlags().StringVar(&secretFile, "jwtsecret", node.JWTSecretFile(defaultDataDir()), "secret file to sign the token for private apis over websocket, used if exists")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 30*time.Second, "timeout of the rpc calls")
+	rootCmd.PersistentFlags().StringVar(&signerAddr, "signer", "", "TCP address of the external signer to sign with the account in it, instead of node keystore")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", outputJSON, "output format of the results, json, yaml or table")
 }
 
 // initConfig reads in the config file and ENV variables if set.
@@ -49,6 +78,60 @@ func initConfig() {
 
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
-		fmt.Println("Using the config file:", viper.ConfigFileUsed())
+		fmt.Fprintln(os.Stderr, "Using the config file:", viper.ConfigFileUsed())
+	}
+}
+
+// defaultDataDir returns the data folder of the node started with the default config.
+func defaultDataDir() string {
+	return filepath.Join(common.GetDefaultDataFolder(), "node1")
+}
+
+// newContext returns the context of rpc calls with the timeout specified in flag.
+func newContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), timeout)
+}
+
+// dialRPC connects to the node via the IPC endpoint if exists, otherwise via the rpc address.
+// Note, the rpc address is always used if specified explicitly, and it fails if not connected
+// within the timeout specified in flag.
+func dialRPC() (*seeleclient.Client, error) {
+	ctx, cancel := newContext()
+	defer cancel()
+
+	return dialRPCContext(ctx)
+}
+
+// dialRPCContext connects to the node with context, see dialRPC.
+func dialRPCContext(ctx context.Context) (*seeleclient.Client, error) {
+	if !rootCmd.PersistentFlags().Changed("addr") && common.FileOrFolderExists(ipcPath) {
+		return seeleclient.DialContext(ctx, "unix", ipcPath)
 	}
+
+	return seeleclient.DialContext(ctx, "tcp", rpcAddr)
+}
+
+// dialWebsocket connects to the node via the websocket address, and
+// the private apis are authenticated if the secret file exists.
+func dialWebsocket(ctx context.Context) (*seeleclient.Client, error) {
+	header, err := authHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	return seeleclient.DialWebsocket(ctx, wsAddr, header)
+}
+
+// authHeader returns the header with bearer token signed by the secret if the secret file exists.
+func authHeader() (http.Header, error) {
+	if !common.FileOrFolderExists(secretFile) {
+		return nil, nil
+	}
+
+	secret, err := rpc.ReadSecret(secretFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return rpc.NewAuthHeader(secret)
 }
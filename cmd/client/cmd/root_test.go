@@ -0,0 +1,37 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"context"
+	"net"
+	"testing"
+)
+
+func Test_DialRPCContext_Canceled(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	defer func(addr, path string) { rpcAddr, ipcPath = addr, path }(rpcAddr, ipcPath)
+	rpcAddr, ipcPath = listener.Addr().String(), ""
+
+	client, err := dialRPCContext(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Close()
+
+	// the context is honored when dialing
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err = dialRPCContext(ctx); err == nil {
+		t.Fatal("expected error for canceled context")
+	}
+}
@@ -6,8 +6,10 @@
 package cmd
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 
+	"github.com/seeleteam/go-seele/cmd/comm"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/common/keystore"
 	"github.com/seeleteam/go-seele/crypto"
@@ -15,31 +17,52 @@ import (
 )
 
 var keyStr *string
+var keySource string
 var keyFile *string
+var keyShortAddress bool
+
+// savedKey is the output of savekey command
+type savedKey struct {
+	Address string `json:"address"`
+	File    string `json:"file"`
+}
 
 // savekey represents the savekey command
 var savekey = &cobra.Command{
 	Use:   "savekey",
 	Short: "save the key",
-	Long: `save the private key
+	Long: `save the private key encrypted with password to the key file. The private key is read
+  from stdin without echo by default, or the file descriptor, or another keystore file, so
+  that it need not be specified in command line, which may be kept in shell history.
     For example:
-		client.exe savekey -k 0x<privatekey>`,
-	Run: func(cmd *cobra.Command, args []string) {
-		privateKey, err := crypto.LoadECDSAFromString(*keyStr)
+		client.exe savekey -f keyfile
+		client.exe savekey -f keyfile --keyfrom fd:3 3<plainkey
+		client.exe savekey -f keyfile --keyfrom keystore:oldkeyfile --kdf pbkdf2`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		var privateKey *ecdsa.PrivateKey
+		var err error
+		if cmd.Flags().Changed("key") {
+			privateKey, err = crypto.LoadECDSAFromString(*keyStr)
+		} else {
+			privateKey, err = comm.ReadPrivateKey(keySource)
+		}
+
 		if err != nil {
-			fmt.Printf("invalid key: %s\n", err.Error())
-			return
+			return nil, invalidInputError("invalid key: %s", err.Error())
 		}
 
 		if keyFile == nil || *keyFile == "" {
-			fmt.Printf("invalid key file path\n")
-			return
+			return nil, invalidInputError("invalid key file path")
+		}
+
+		params, err := keystore.GetParams(keystoreKDF, keystoreLight)
+		if err != nil {
+			return nil, invalidInputError("%s", err.Error())
 		}
 
 		pass, err := common.SetPassword()
 		if err != nil {
-			fmt.Printf("get password err %s\n", err.Error())
-			return
+			return nil, fmt.Errorf("get password err %s", err.Error())
 		}
 
 		key := keystore.Key{
@@ -47,15 +70,26 @@ var savekey = &cobra.Command{
 			PrivateKey: privateKey,
 		}
 
-		keystore.StoreKey(*keyFile, pass, &key)
-	},
+		if keyShortAddress {
+			key.Address = *crypto.GetShortAddress(privateKey)
+		}
+
+		if err = keystore.StoreKeyWithParams(*keyFile, pass, &key, params); err != nil {
+			return nil, err
+		}
+
+		return &savedKey{key.Address.ToHex(), *keyFile}, nil
+	}),
 }
 
 func init() {
 	rootCmd.AddCommand(savekey)
 
-	keyStr = savekey.Flags().StringP("key", "k", "", "private key")
-	savekey.MarkFlagRequired("key")
+	keyStr = savekey.Flags().StringP("key", "k", "", "private key, which is discouraged since it may be kept in shell history, use --keyfrom instead")
+	savekey.Flags().StringVar(&keySource, "keyfrom", comm.KeySourceStdin, comm.KeySourceUsage)
 
 	keyFile = savekey.Flags().StringP("file", "f", ".keystore", "key file")
+	savekey.Flags().StringVar(&keystoreKDF, "kdf", keystore.KDFScrypt, "KDF to derive the encryption key from password, scrypt or pbkdf2")
+	savekey.Flags().BoolVar(&keyShortAddress, "short", false, "whether to use the short address of the key, which is only supported if enabled in chain config")
+	savekey.Flags().BoolVar(&keystoreLight, "light", false, "whether to use the KDF of light strength, which is faster but less secure")
 }
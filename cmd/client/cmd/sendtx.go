@@ -9,23 +9,33 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/seeleteam/go-seele/accounts/signer"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/common/keystore"
 	"github.com/seeleteam/go-seele/core/types"
-	"github.com/seeleteam/go-seele/crypto"
-	"github.com/seeleteam/go-seele/rpc"
+	"github.com/seeleteam/go-seele/seele"
 	"github.com/spf13/cobra"
 )
 
 type txInfo struct {
 	amount *string // amount specifies the coin amount to be transferred
 	to     *string // to is the public address of the receiver
-	from   *string // from is the key file path of the sender
+	from   *string // from is the key file path of the sender, or the address of account in node keystore
 	fee    *string // transaction fee
 }
 
 var parameter = txInfo{}
 
+// sendTxResult is the output of sendtx command
+type sendTxResult struct {
+	TxHash       string   `json:"txHash"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Amount       *big.Int `json:"amount"`
+	Fee          *big.Int `json:"fee"`
+	AccountNonce *uint64  `json:"accountNonce,omitempty"` // nil if signed in node
+}
+
 // sendtxCmd represents the sendtx command
 var sendtxCmd = &cobra.Command{
 	Use:   "sendtx",
@@ -33,77 +43,132 @@ var sendtxCmd = &cobra.Command{
 	Long: `send a tx to the miner
   For example:
     client.exe sendtx -m 0 -t 0x<public address> -f keyfile
-    client.exe sendtx -a 127.0.0.1:55027 -m 0 -t 0x<public address> -f keyfile `,
-	Run: func(cmd *cobra.Command, args []string) {
-		client, err := rpc.Dial("tcp", rpcAddr)
+    client.exe sendtx -a 127.0.0.1:55027 -m 0 -t 0x<public address> -f keyfile
+    client.exe sendtx -m 0 -t 0x<public address> -f 0x<unlocked account address>
+    client.exe sendtx -m 0 -t 0x<public address> -f 0x<account address> --signer 127.0.0.1:55030`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		toAddr, err := common.HexToAddress(*parameter.to)
 		if err != nil {
-			fmt.Printf("invalid address: %s\n", err.Error())
-			return
+			return nil, invalidInputError("invalid receiver address: %s", err.Error())
 		}
-		defer client.Close()
 
-		toAddr, err := common.HexToAddress(*parameter.to)
-		if err != nil {
-			fmt.Printf("invalid receiver address: %s\n", err.Error())
-			return
+		amount, ok := big.NewInt(0).SetString(*parameter.amount, 10)
+		if !ok {
+			return nil, invalidInputError("invalid amount value")
 		}
 
-		pass, err := common.GetPassword()
-		if err != nil {
-			fmt.Printf("get password failed %s\n", err.Error())
-			return
+		fee, ok := big.NewInt(0).SetString(*parameter.fee, 10)
+		if !ok {
+			return nil, invalidInputError("invalid fee value")
 		}
 
-		key, err := keystore.GetKey(*parameter.from, pass)
-		if err != nil {
-			fmt.Printf("invalid sender key file. it should be a private key: %s\n", err.Error())
-			return
+		// signed in external signer or in node with the unlocked account if the sender is not a key file
+		if from, err := common.HexToAddress(*parameter.from); err == nil && !common.FileOrFolderExists(*parameter.from) {
+			if len(signerAddr) > 0 {
+				return sendTxWithSigner(from, toAddr, amount, fee)
+			}
+
+			return sendTxInNode(from, toAddr, amount, fee)
 		}
 
-		from, err := crypto.GetAddress(key.PrivateKey)
+		pass, err := common.GetPassword()
 		if err != nil {
-			fmt.Printf("generating the sender address failed: %s\n", err.Error())
-			return
+			return nil, fmt.Errorf("get password failed %s", err.Error())
 		}
 
-		var nonce uint64
-		err = client.Call("seele.GetAccountNonce", &from, &nonce)
+		key, err := keystore.GetKey(*parameter.from, pass)
 		if err != nil {
-			fmt.Printf("getting the sender account nonce failed: %s\n", err.Error())
-			return
+			return nil, invalidInputError("invalid sender key file. it should be a private key: %s", err.Error())
 		}
 
-		fmt.Printf("got the sender account nonce: %d\n", nonce)
-
-		amount, ok := big.NewInt(0).SetString(*parameter.amount, 10)
-		if !ok {
-			fmt.Println("invalid amount value")
-			return
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
 		}
+		defer client.Close()
 
-		fee, ok := big.NewInt(0).SetString(*parameter.fee, 10)
-		if !ok {
-			fmt.Println("invalid fee value")
-			return
-		}
+		ctx, cancel := newContext()
+		defer cancel()
 
-		tx, err := types.NewTransaction(*from, toAddr, amount, fee, nonce)
+		tx, err := client.TransferFrom(ctx, key.Address, key.PrivateKey, toAddr, amount, fee)
 		if err != nil {
-			fmt.Println(err.Error())
-			return
+			return nil, callError(fmt.Errorf("adding the tx failed: %s", err.Error()))
 		}
-		tx.Sign(key.PrivateKey)
 
-		var result bool
-		err = client.Call("seele.AddTx", &tx, &result)
-		if !result || err != nil {
-			fmt.Printf("adding the tx failed: %s\n", err.Error())
-			return
-		}
+		return &sendTxResult{
+			TxHash:       tx.Hash.ToHex(),
+			From:         tx.Data.From.ToHex(),
+			To:           tx.Data.To.ToHex(),
+			Amount:       tx.Data.Amount,
+			Fee:          tx.Data.Fee,
+			AccountNonce: &tx.Data.AccountNonce,
+		}, nil
+	}),
+}
+
+// sendTxInNode sends the tx signed with the unlocked account in node keystore.
+func sendTxInNode(from, to common.Address, amount, fee *big.Int) (*sendTxResult, error) {
+	client, err := dialRPC()
+	if err != nil {
+		return nil, connectionError(err)
+	}
+	defer client.Close()
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	request := seele.SendTxRequest{From: from, To: &to, Amount: amount, Fee: fee}
+	hash, err := client.SendTransaction(ctx, request)
+	if err != nil {
+		return nil, callError(fmt.Errorf("adding the tx failed: %s", err.Error()))
+	}
+
+	return &sendTxResult{
+		TxHash: hash,
+		From:   from.ToHex(),
+		To:     to.ToHex(),
+		Amount: amount,
+		Fee:    fee,
+	}, nil
+}
 
-		fmt.Println("txhash:", tx.Hash.ToHex())
-		fmt.Println("adding the tx succeeded.")
-	},
+// sendTxWithSigner sends the tx signed with the account in external signer.
+func sendTxWithSigner(from, to common.Address, amount, fee *big.Int) (*sendTxResult, error) {
+	client, err := dialRPC()
+	if err != nil {
+		return nil, connectionError(err)
+	}
+	defer client.Close()
+
+	ctx, cancel := newContext()
+	defer cancel()
+
+	nonce, err := client.GetAccountNonce(ctx, from)
+	if err != nil {
+		return nil, callError(err)
+	}
+
+	tx, err := types.NewTransaction(from, to, amount, fee, nonce)
+	if err != nil {
+		return nil, invalidInputError("%s", err.Error())
+	}
+
+	if err = signer.NewClient(signerAddr, 0).SignTx(tx); err != nil {
+		return nil, callError(fmt.Errorf("signing the tx failed: %s", err.Error()))
+	}
+
+	if err = client.AddTx(ctx, tx); err != nil {
+		return nil, callError(fmt.Errorf("adding the tx failed: %s", err.Error()))
+	}
+
+	return &sendTxResult{
+		TxHash:       tx.Hash.ToHex(),
+		From:         tx.Data.From.ToHex(),
+		To:           tx.Data.To.ToHex(),
+		Amount:       tx.Data.Amount,
+		Fee:          tx.Data.Fee,
+		AccountNonce: &tx.Data.AccountNonce,
+	}, nil
 }
 
 func init() {
@@ -115,7 +180,7 @@ func init() {
 	parameter.amount = sendtxCmd.Flags().StringP("amount", "m", "", "the amount of the transferred coins")
 	sendtxCmd.MarkFlagRequired("amount")
 
-	parameter.from = sendtxCmd.Flags().StringP("from", "f", "", "key file path of the sender")
+	parameter.from = sendtxCmd.Flags().StringP("from", "f", "", "key file path of the sender, or address of the unlocked account in node keystore or the account in external signer")
 	sendtxCmd.MarkFlagRequired("from")
 
 	parameter.fee = sendtxCmd.Flags().StringP("fee", "", "", "transaction fee")
@@ -0,0 +1,198 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+
+	"github.com/seeleteam/go-seele/accounts/signer"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/spf13/cobra"
+)
+
+var signTxParameter = txInfo{}
+var signTxNonce uint64
+var signedTxFile string
+var rawTxFile string
+var rawTx string
+
+// signTxResult is the output of signtx command
+type signTxResult struct {
+	sendTxResult
+	File string `json:"file"`
+}
+
+// signtxCmd represents the command to sign tx offline
+var signtxCmd = &cobra.Command{
+	Use:   "signtx",
+	Short: "sign a tx offline and save it to file",
+	Long: `sign a tx with the key file and the explicit nonce without contacting a node, and
+  save the signed tx in RLP HEX to file, which could be sent with sendrawtx later.
+  For example:
+    client.exe signtx -m 0 -t 0x<public address> -f keyfile --fee 1 --nonce 0 --txfile signed.tx
+    client.exe signtx -m 0 -t 0x<public address> -f 0x<account address> --signer 127.0.0.1:55030 --fee 1 --nonce 0`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		toAddr, err := common.HexToAddress(*signTxParameter.to)
+		if err != nil {
+			return nil, invalidInputError("invalid receiver address: %s", err.Error())
+		}
+
+		amount, ok := big.NewInt(0).SetString(*signTxParameter.amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, invalidInputError("invalid amount value")
+		}
+
+		fee, ok := big.NewInt(0).SetString(*signTxParameter.fee, 10)
+		if !ok || fee.Sign() < 0 {
+			return nil, invalidInputError("invalid fee value")
+		}
+
+		var tx *types.Transaction
+
+		// signed in external signer if the sender is not a key file
+		if from, err := common.HexToAddress(*signTxParameter.from); err == nil && len(signerAddr) > 0 && !common.FileOrFolderExists(*signTxParameter.from) {
+			if tx, err = types.NewTransaction(from, toAddr, amount, fee, signTxNonce); err != nil {
+				return nil, err
+			}
+
+			if err = signer.NewClient(signerAddr, 0).SignTx(tx); err != nil {
+				return nil, callError(fmt.Errorf("signing the tx failed: %s", err.Error()))
+			}
+		} else {
+			pass, err := common.GetPassword()
+			if err != nil {
+				return nil, fmt.Errorf("get password failed %s", err.Error())
+			}
+
+			key, err := keystore.GetKey(*signTxParameter.from, pass)
+			if err != nil {
+				return nil, invalidInputError("invalid sender key file. it should be a private key: %s", err.Error())
+			}
+
+			if tx, err = types.NewTransaction(key.Address, toAddr, amount, fee, signTxNonce); err != nil {
+				return nil, err
+			}
+
+			tx.Sign(key.PrivateKey)
+		}
+
+		if err = writeTxFile(signedTxFile, tx); err != nil {
+			return nil, err
+		}
+
+		return &signTxResult{
+			sendTxResult: sendTxResult{
+				TxHash:       tx.Hash.ToHex(),
+				From:         tx.Data.From.ToHex(),
+				To:           tx.Data.To.ToHex(),
+				Amount:       tx.Data.Amount,
+				Fee:          tx.Data.Fee,
+				AccountNonce: &tx.Data.AccountNonce,
+			},
+			File: signedTxFile,
+		}, nil
+	}),
+}
+
+// sendrawtxCmd represents the command to send the signed tx
+var sendrawtxCmd = &cobra.Command{
+	Use:   "sendrawtx",
+	Short: "send a signed tx in RLP HEX to the miner",
+	Long: `send a signed tx in RLP HEX to the miner, e.g. the tx signed offline with signtx
+  For example:
+    client.exe sendrawtx --txfile signed.tx
+    client.exe sendrawtx --rawtx 0x<signed tx>`,
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		if (len(rawTx) == 0) == (len(rawTxFile) == 0) {
+			return nil, invalidInputError("either --rawtx or --txfile should be specified")
+		}
+
+		if len(rawTxFile) > 0 {
+			content, err := ioutil.ReadFile(rawTxFile)
+			if err != nil {
+				return nil, invalidInputError("failed to read tx file: %s", err.Error())
+			}
+
+			rawTx = strings.TrimSpace(string(content))
+		}
+
+		client, err := dialRPC()
+		if err != nil {
+			return nil, connectionError(err)
+		}
+		defer client.Close()
+
+		ctx, cancel := newContext()
+		defer cancel()
+
+		hash, err := client.SendRawTransaction(ctx, rawTx)
+		if err != nil {
+			return nil, callError(fmt.Errorf("adding the tx failed: %s", err.Error()))
+		}
+
+		return map[string]string{"txHash": hash}, nil
+	}),
+}
+
+// writeTxFile saves the tx in RLP HEX to file.
+func writeTxFile(file string, tx *types.Transaction) error {
+	encoded, err := common.Serialize(tx)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, []byte(hexutil.BytesToHex(encoded)), 0600)
+}
+
+// readTxFile reads the tx in RLP HEX from file.
+func readTxFile(file string) (*types.Transaction, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, invalidInputError("failed to read tx file: %s", err.Error())
+	}
+
+	encoded, err := hexutil.HexToBytes(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, invalidInputError("invalid tx file: %s", err.Error())
+	}
+
+	tx := new(types.Transaction)
+	if err = common.Deserialize(encoded, tx); err != nil || tx.Data == nil {
+		return nil, invalidInputError("invalid tx file, failed to decode the tx")
+	}
+
+	return tx, nil
+}
+
+func init() {
+	rootCmd.AddCommand(signtxCmd, sendrawtxCmd)
+
+	signTxParameter.to = signtxCmd.Flags().StringP("to", "t", "", "public address of the receiver")
+	signtxCmd.MarkFlagRequired("to")
+
+	signTxParameter.amount = signtxCmd.Flags().StringP("amount", "m", "", "the amount of the transferred coins")
+	signtxCmd.MarkFlagRequired("amount")
+
+	signTxParameter.from = signtxCmd.Flags().StringP("from", "f", "", "key file path of the sender, or address of the account in external signer")
+	signtxCmd.MarkFlagRequired("from")
+
+	signTxParameter.fee = signtxCmd.Flags().StringP("fee", "", "", "transaction fee")
+	signtxCmd.MarkFlagRequired("fee")
+
+	signtxCmd.Flags().Uint64Var(&signTxNonce, "nonce", 0, "account nonce of the sender")
+	signtxCmd.MarkFlagRequired("nonce")
+
+	signtxCmd.Flags().StringVar(&signedTxFile, "txfile", "signed.tx", "file to save the signed tx")
+
+	sendrawtxCmd.Flags().StringVar(&rawTxFile, "txfile", "", "file of the signed tx")
+	sendrawtxCmd.Flags().StringVar(&rawTx, "rawtx", "", "signed tx in RLP HEX")
+}
@@ -0,0 +1,221 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/accounts/hdwallet"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/spf13/cobra"
+)
+
+var mnemonicBits int
+var walletKeystore string
+var walletKeyFile string
+var walletIndex uint32
+var walletShard uint
+var walletPath string
+var walletCount uint32
+
+// walletAccount is the output of the derived account
+type walletAccount struct {
+	Index   *uint32 `json:"index,omitempty"`
+	Path    string  `json:"path"`
+	Address string  `json:"address"`
+	Shard   uint    `json:"shard"`
+	File    string  `json:"file"`
+}
+
+// walletCmd represents the command to manage the HD wallets
+var walletCmd = &cobra.Command{
+	Use:   "wallet",
+	Short: "manage the hierarchical deterministic wallets of mnemonic",
+	Long: `manage the hierarchical deterministic wallets, whose accounts are derived from the
+  BIP-39 mnemonic in the path m/44'/5420'/0'/0/<index>, and saved in the keystore.
+  For example:
+    client.exe wallet create
+    client.exe wallet restore [--count 5]
+    client.exe wallet derive --index 1
+    client.exe wallet derive --shard 2 [--index 0]
+    client.exe wallet derive --path "m/44'/5420'/1'/0/0" --file .keystore`,
+}
+
+// walletCreateCmd represents the command to create mnemonic
+var walletCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "create a mnemonic of new wallet",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		mnemonic, err := hdwallet.NewMnemonic(mnemonicBits)
+		if err != nil {
+			return nil, invalidInputError("%s", err.Error())
+		}
+
+		fmt.Fprintln(os.Stderr, "Please write down the mnemonic and keep it safe, it is the only way to restore the wallet.")
+
+		return map[string]string{"mnemonic": mnemonic}, nil
+	}),
+}
+
+// walletRestoreCmd represents the command to restore the accounts of mnemonic
+var walletRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "restore the first accounts of the mnemonic into keystore",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		if walletCount == 0 {
+			return nil, invalidInputError("invalid count")
+		}
+
+		wallet, err := getWallet()
+		if err != nil {
+			return nil, err
+		}
+
+		pass, err := common.SetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password err %s", err.Error())
+		}
+
+		var result []*walletAccount
+		for index := uint32(0); index < walletCount; index++ {
+			privateKey, err := wallet.DeriveAccount(index)
+			if err != nil {
+				return nil, err
+			}
+
+			account, err := saveWalletKey(privateKey, hdwallet.DefaultBasePath.Child(index), pass)
+			if err != nil {
+				return nil, err
+			}
+
+			i := index
+			account.Index = &i
+			result = append(result, account)
+		}
+
+		return result, nil
+	}),
+}
+
+// walletDeriveCmd represents the command to derive an account of mnemonic
+var walletDeriveCmd = &cobra.Command{
+	Use:   "derive",
+	Short: "derive an account of the mnemonic by index, shard or path into keystore",
+	Run: runWithOutput(func(cmd *cobra.Command, args []string) (interface{}, error) {
+		var path hdwallet.DerivationPath
+		if len(walletPath) > 0 {
+			var err error
+			if path, err = hdwallet.ParseDerivationPath(walletPath); err != nil {
+				return nil, invalidInputError("%s", err.Error())
+			}
+		}
+
+		if walletShard > common.ShardNumber {
+			return nil, invalidInputError("invalid shard number, it should be in [0, %d]", common.ShardNumber)
+		}
+
+		wallet, err := getWallet()
+		if err != nil {
+			return nil, err
+		}
+
+		var privateKey *ecdsa.PrivateKey
+		index := walletIndex
+		if path != nil {
+			privateKey, err = wallet.Derive(path)
+		} else {
+			privateKey, index, err = wallet.NextShardAccount(walletShard, walletIndex)
+			path = hdwallet.DefaultBasePath.Child(index)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		pass, err := common.SetPassword()
+		if err != nil {
+			return nil, fmt.Errorf("get password err %s", err.Error())
+		}
+
+		account, err := saveWalletKey(privateKey, path, pass)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(walletPath) == 0 {
+			account.Index = &index
+		}
+
+		return account, nil
+	}),
+}
+
+// getWallet reads the mnemonic and creates the wallet.
+func getWallet() (*hdwallet.Wallet, error) {
+	mnemonic, pass, err := common.GetMnemonic()
+	if err != nil {
+		return nil, fmt.Errorf("get mnemonic err %s", err.Error())
+	}
+
+	wallet, err := hdwallet.NewFromMnemonic(mnemonic, pass)
+	if err != nil {
+		return nil, invalidInputError("%s", err.Error())
+	}
+
+	return wallet, nil
+}
+
+// saveWalletKey saves the derived key in the key file if specified, otherwise the keystore folder.
+func saveWalletKey(privateKey *ecdsa.PrivateKey, path hdwallet.DerivationPath, pass string) (*walletAccount, error) {
+	key := &keystore.Key{
+		Address:    *crypto.MustGetAddress(privateKey),
+		PrivateKey: privateKey,
+	}
+
+	file := walletKeyFile
+	if len(file) > 0 {
+		if err := keystore.StoreKey(file, pass, key); err != nil {
+			return nil, err
+		}
+	} else {
+		account, err := accounts.NewManager(walletKeystore).Import(key, pass)
+		if err != nil {
+			return nil, err
+		}
+
+		file = account.File
+	}
+
+	return &walletAccount{
+		Path:    path.String(),
+		Address: key.Address.ToHex(),
+		Shard:   common.GetShardNumber(key.Address),
+		File:    file,
+	}, nil
+}
+
+func init() {
+	rootCmd.AddCommand(walletCmd)
+	walletCmd.AddCommand(walletCreateCmd, walletRestoreCmd, walletDeriveCmd)
+
+	walletCreateCmd.Flags().IntVar(&mnemonicBits, "bits", hdwallet.DefaultEntropyBits, "entropy bits of mnemonic, 128 for 12 words and 256 for 24 words")
+
+	for _, cmd := range []*cobra.Command{walletRestoreCmd, walletDeriveCmd} {
+		cmd.Flags().StringVar(&walletKeystore, "keystore", accounts.KeystoreDir(defaultDataDir()), "keystore folder to save the accounts")
+	}
+
+	walletRestoreCmd.Flags().Uint32Var(&walletCount, "count", 1, "number of accounts to restore from index 0")
+
+	walletDeriveCmd.Flags().Uint32Var(&walletIndex, "index", 0, "account index, or the index to search from if shard specified")
+	walletDeriveCmd.Flags().UintVar(&walletShard, "shard", 0, "shard number of the account, any shard if 0")
+	walletDeriveCmd.Flags().StringVar(&walletPath, "path", "", "derivation path, e.g. m/44'/5420'/0'/0/1, overrides index and shard")
+	walletDeriveCmd.Flags().StringVarP(&walletKeyFile, "file", "f", "", "key file to save the account instead of the keystore folder")
+}
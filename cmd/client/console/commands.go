@@ -0,0 +1,177 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package console
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+)
+
+var (
+	errInvalidArgs = errors.New("invalid arguments")
+	errKeyLocked   = errors.New("no key unlocked, unlock the key file first")
+)
+
+// command is a built-in command of console.
+type command struct {
+	usage string
+	run   func(c *Console, args []string) error
+}
+
+// builtinCommands are the built-in commands, which are initialized in init to
+// avoid the initialization loop of the help command.
+var builtinCommands map[string]*command
+
+func init() {
+	builtinCommands = map[string]*command{
+		"help":     {"help: show the available commands", help},
+		"exit":     {"exit: exit the console", exit},
+		"quit":     {"quit: exit the console", exit},
+		"modules":  {"modules: show the api modules and versions", modules},
+		"toseele":  {"toseele <amount>: convert the amount in fan to seele", toSeele},
+		"tofan":    {"tofan <amount>: convert the amount in seele to fan", toFan},
+		"unlock":   {"unlock <keyfile>: unlock the key file to sign transactions", unlock},
+		"lock":     {"lock: lock the unlocked key", lock},
+		"transfer": {"transfer <to> <amount> <fee>: transfer the amount in fan with the unlocked key", transfer},
+	}
+}
+
+func help(c *Console, args []string) error {
+	var names []string
+	for name := range builtinCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(c.out, "built-in commands:")
+	for _, name := range names {
+		fmt.Fprintf(c.out, "  %s\n", builtinCommands[name].usage)
+	}
+
+	var methods []string
+	for name := range c.methods {
+		methods = append(methods, name)
+	}
+	sort.Strings(methods)
+
+	fmt.Fprintln(c.out, "api methods, with optional params in JSON:")
+	for _, name := range methods {
+		fmt.Fprintf(c.out, "  %s\n", name)
+	}
+
+	return nil
+}
+
+func exit(c *Console, args []string) error {
+	return errExit
+}
+
+func modules(c *Console, args []string) error {
+	ctx, cancel := c.newContext()
+	defer cancel()
+
+	modules, err := c.client.Modules(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.print(modules)
+}
+
+func toSeele(c *Console, args []string) error {
+	if len(args) != 1 {
+		return errInvalidArgs
+	}
+
+	amount, ok := big.NewInt(0).SetString(args[0], 10)
+	if !ok {
+		return errInvalidArgs
+	}
+
+	fmt.Fprintln(c.out, common.BigToDecimal(amount))
+	return nil
+}
+
+func toFan(c *Console, args []string) error {
+	if len(args) != 1 {
+		return errInvalidArgs
+	}
+
+	amount, err := common.DecimalToBig(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, amount.String())
+	return nil
+}
+
+func unlock(c *Console, args []string) error {
+	if len(args) != 1 {
+		return errInvalidArgs
+	}
+
+	pass, err := c.readPassword("Please input your key file password: ")
+	if err != nil {
+		return err
+	}
+
+	key, err := keystore.GetKey(args[0], pass)
+	if err != nil {
+		return err
+	}
+
+	c.key = key
+	fmt.Fprintf(c.out, "unlocked account: %s\n", key.Address.ToHex())
+	return nil
+}
+
+func lock(c *Console, args []string) error {
+	c.key = nil
+	return nil
+}
+
+func transfer(c *Console, args []string) error {
+	if len(args) != 3 {
+		return errInvalidArgs
+	}
+
+	if c.key == nil {
+		return errKeyLocked
+	}
+
+	to, err := common.HexToAddress(args[0])
+	if err != nil {
+		return err
+	}
+
+	amount, ok := big.NewInt(0).SetString(args[1], 10)
+	if !ok {
+		return fmt.Errorf("invalid amount value %s", args[1])
+	}
+
+	fee, ok := big.NewInt(0).SetString(args[2], 10)
+	if !ok {
+		return fmt.Errorf("invalid fee value %s", args[2])
+	}
+
+	ctx, cancel := c.newContext()
+	defer cancel()
+
+	tx, err := c.client.TransferFrom(ctx, c.key.Address, c.key.PrivateKey, to, amount, fee)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "from: %s, nonce: %d\n", tx.Data.From.ToHex(), tx.Data.AccountNonce)
+	fmt.Fprintf(c.out, "txhash: %s\n", tx.Hash.ToHex())
+	return nil
+}
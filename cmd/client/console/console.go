@@ -0,0 +1,216 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package console
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/howeyc/gopass"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/seeleclient"
+)
+
+const (
+	// commentPrefix is the prefix of comment lines, which are ignored.
+	commentPrefix = "#"
+
+	// defaultTimeout is the timeout of api calls if not specified.
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	errExit           = errors.New("exit")
+	errUnknownCommand = errors.New("unknown command, type \"help\" for the available commands")
+)
+
+// Config is the configuration to create a console.
+type Config struct {
+	Client       *seeleclient.Client                 // client connected to the node
+	Timeout      time.Duration                       // timeout of each api call, 30 seconds if not specified
+	Output       io.Writer                           // output of the commands, os.Stdout if nil
+	ReadPassword func(prompt string) (string, error) // reads password to unlock key, from stdin if nil
+}
+
+// Console executes the built-in commands and the api methods served by the node,
+// e.g. "seele.getblockheight", line by line.
+type Console struct {
+	client       *seeleclient.Client
+	timeout      time.Duration
+	out          io.Writer
+	readPassword func(prompt string) (string, error)
+
+	methods map[string]string // lower case command to api method, e.g. seele.getbalance to seele.GetBalance
+	key     *keystore.Key     // unlocked key to sign transactions
+}
+
+// New creates a console with the api methods discovered from the node.
+func New(config Config) (*Console, error) {
+	c := &Console{
+		client:       config.Client,
+		timeout:      config.Timeout,
+		out:          config.Output,
+		readPassword: config.ReadPassword,
+		methods:      make(map[string]string),
+	}
+
+	if c.out == nil {
+		c.out = os.Stdout
+	}
+
+	if c.timeout <= 0 {
+		c.timeout = defaultTimeout
+	}
+
+	if c.readPassword == nil {
+		c.readPassword = c.readPasswordFromStdin
+	}
+
+	ctx, cancel := c.newContext()
+	defer cancel()
+
+	modules, err := c.client.Describe(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, module := range modules {
+		for _, method := range module.Methods {
+			serviceMethod := module.Namespace + "." + method.Name
+			c.methods[strings.ToLower(serviceMethod)] = serviceMethod
+		}
+	}
+
+	return c, nil
+}
+
+// Execute executes a line of command, which is the built-in command or api method with
+// params in JSON. The string params could be unquoted, e.g. "seele.getbalance 0x<address>".
+func (c *Console) Execute(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, commentPrefix) {
+		return nil
+	}
+
+	name, params := line, ""
+	if i := strings.IndexAny(line, " \t"); i >= 0 {
+		name, params = line[:i], strings.TrimSpace(line[i+1:])
+	}
+
+	name = strings.ToLower(name)
+	if command, ok := builtinCommands[name]; ok {
+		return command.run(c, strings.Fields(params))
+	}
+
+	method, ok := c.methods[name]
+	if !ok {
+		return errUnknownCommand
+	}
+
+	return c.call(method, parseParams(params))
+}
+
+// RunScript executes the commands in script line by line, and stops at the first failed
+// command or the "exit" command. The empty lines and comment lines are ignored.
+func (c *Console) RunScript(script io.Reader) error {
+	scanner := bufio.NewScanner(script)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+
+		fmt.Fprintf(c.out, "> %s\n", line)
+
+		if err := c.Execute(line); err == errExit {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("line %d: %s", lineNum, err.Error())
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Completions returns the sorted commands with the specified prefix, ignoring case.
+func (c *Console) Completions(prefix string) []string {
+	prefix = strings.ToLower(prefix)
+
+	var completions []string
+	for name := range builtinCommands {
+		if strings.HasPrefix(name, prefix) {
+			completions = append(completions, name)
+		}
+	}
+
+	for name := range c.methods {
+		if strings.HasPrefix(name, prefix) {
+			completions = append(completions, name)
+		}
+	}
+
+	sort.Strings(completions)
+	return completions
+}
+
+// call calls the api method and prints the result in JSON.
+func (c *Console) call(method string, params interface{}) error {
+	ctx, cancel := c.newContext()
+	defer cancel()
+
+	var result interface{}
+	if err := c.client.Call(ctx, method, params, &result); err != nil {
+		return err
+	}
+
+	return c.print(result)
+}
+
+// print prints the value in indented JSON.
+func (c *Console) print(value interface{}) error {
+	encoded, err := json.MarshalIndent(value, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, string(encoded))
+	return nil
+}
+
+func (c *Console) newContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), c.timeout)
+}
+
+func (c *Console) readPasswordFromStdin(prompt string) (string, error) {
+	fmt.Fprint(c.out, prompt)
+	pass, err := gopass.GetPasswd()
+	if err != nil {
+		return "", err
+	}
+
+	return string(pass), nil
+}
+
+// parseParams returns the params in JSON, or as string if not valid JSON.
+func parseParams(params string) interface{} {
+	if params == "" {
+		return nil
+	}
+
+	if json.Valid([]byte(params)) {
+		return json.RawMessage(params)
+	}
+
+	return params
+}
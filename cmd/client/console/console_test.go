@@ -0,0 +1,170 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package console
+
+import (
+	"bytes"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/seeleteam/go-seele/rpc"
+	"github.com/seeleteam/go-seele/seeleclient"
+)
+
+type testSeeleAPI struct {
+	txs []*types.Transaction
+}
+
+func (api *testSeeleAPI) GetBalance(account *common.Address, result *big.Int) error {
+	result.SetInt64(100)
+	return nil
+}
+
+func (api *testSeeleAPI) GetBlockHeight(input interface{}, height *uint64) error {
+	*height = 8
+	return nil
+}
+
+func (api *testSeeleAPI) GetAccountNonce(account *common.Address, nonce *uint64) error {
+	*nonce = 3
+	return nil
+}
+
+func (api *testSeeleAPI) AddTx(tx *types.Transaction, result *bool) error {
+	api.txs = append(api.txs, tx)
+	*result = true
+	return nil
+}
+
+func newTestConsole(t *testing.T) (*Console, *testSeeleAPI, *bytes.Buffer) {
+	api := &testSeeleAPI{}
+	apis := []rpc.API{{Namespace: "seele", Version: "1.0", Service: api, Public: true}}
+
+	server := rpc.NewServer()
+	for _, api := range append(apis, rpc.NewRPCService(apis).API()) {
+		if err := server.RegisterName(api.Namespace, api.Service); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cli, srv := net.Pipe()
+	go server.ServeCodec(rpc.NewJSONCodec(srv, &server.Server))
+
+	out := new(bytes.Buffer)
+	c, err := New(Config{
+		Client: seeleclient.NewClient(rpc.NewClient(cli)),
+		Output: out,
+		ReadPassword: func(prompt string) (string, error) {
+			return "123", nil
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c, api, out
+}
+
+func Test_Console_Execute(t *testing.T) {
+	c, _, out := newTestConsole(t)
+	defer c.client.Close()
+
+	// api methods in any case, and the string params unquoted
+	assert.Equal(t, c.Execute("Seele.GetBlockHeight"), nil)
+	assert.Equal(t, out.String(), "8\n")
+
+	out.Reset()
+	assert.Equal(t, c.Execute("seele.getbalance "+crypto.MustGenerateRandomAddress().ToHex()), nil)
+	assert.Equal(t, out.String(), "100\n")
+
+	// built-in commands
+	out.Reset()
+	assert.Equal(t, c.Execute("toseele 100012345678"), nil)
+	assert.Equal(t, c.Execute("tofan 8.000006"), nil)
+	assert.Equal(t, out.String(), "1000.12345678\n800000600\n")
+
+	assert.Equal(t, c.Execute("# comment"), nil)
+	assert.Equal(t, c.Execute("toseele"), errInvalidArgs)
+	assert.Equal(t, c.Execute("seele.unknown"), errUnknownCommand)
+	assert.Equal(t, c.Execute("exit"), errExit)
+}
+
+func Test_Console_Transfer(t *testing.T) {
+	c, api, out := newTestConsole(t)
+	defer c.client.Close()
+
+	to := crypto.MustGenerateRandomAddress().ToHex()
+	assert.Equal(t, c.Execute("transfer "+to+" 10 1"), errKeyLocked)
+
+	from, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyFile := filepath.Join(common.GetTempFolder(), "console_test_key")
+	defer os.Remove(keyFile)
+
+	if err = keystore.StoreKey(keyFile, "123", &keystore.Key{Address: *from, PrivateKey: privateKey}); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, c.Execute("unlock "+keyFile), nil)
+	assert.Equal(t, c.Execute("transfer "+to+" 10 1"), nil)
+	assert.Equal(t, len(api.txs), 1)
+	assert.Equal(t, api.txs[0].Data.From, *from)
+	assert.Equal(t, api.txs[0].Data.AccountNonce, uint64(3))
+	assert.Equal(t, strings.Contains(out.String(), api.txs[0].Hash.ToHex()), true)
+
+	assert.Equal(t, c.Execute("lock"), nil)
+	assert.Equal(t, c.Execute("transfer "+to+" 10 1"), errKeyLocked)
+}
+
+func Test_Console_RunScript(t *testing.T) {
+	c, _, out := newTestConsole(t)
+	defer c.client.Close()
+
+	script := "# runbook\n\nseele.getblockheight\ntoseele 100000000\nexit\nseele.getblockheight\n"
+	assert.Equal(t, c.RunScript(strings.NewReader(script)), nil)
+	assert.Equal(t, out.String(), "> seele.getblockheight\n8\n> toseele 100000000\n1\n> exit\n")
+
+	// stops at the first failed command
+	out.Reset()
+	err := c.RunScript(strings.NewReader("seele.getblockheight\nunknown\nseele.getblockheight\n"))
+	assert.Equal(t, err.Error(), "line 2: "+errUnknownCommand.Error())
+	assert.Equal(t, out.String(), "> seele.getblockheight\n8\n> unknown\n")
+}
+
+func Test_Console_Completions(t *testing.T) {
+	c, _, _ := newTestConsole(t)
+	defer c.client.Close()
+
+	assert.Equal(t, c.Completions("seele.get"), []string{"seele.getaccountnonce", "seele.getbalance", "seele.getblockheight"})
+	assert.Equal(t, c.Completions("T"), []string{"tofan", "toseele", "transfer"})
+
+	line, pos, ok := c.autoComplete("seele.getb", 10, '\t')
+	assert.Equal(t, line, "seele.getb")
+	assert.Equal(t, pos, 10)
+	assert.Equal(t, ok, true)
+
+	line, pos, ok = c.autoComplete("seele.getbl", 11, '\t')
+	assert.Equal(t, line, "seele.getblockheight ")
+	assert.Equal(t, pos, 21)
+	assert.Equal(t, ok, true)
+
+	// completes command name only
+	_, _, ok = c.autoComplete("toseele 1", 9, '\t')
+	assert.Equal(t, ok, false)
+}
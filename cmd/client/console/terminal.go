@@ -0,0 +1,216 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package console
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	prompt = "> "
+
+	// maxHistory is the max number of commands kept in the history file,
+	// which is the same as the history capacity of terminal.
+	maxHistory = 100
+
+	// sensitiveNamespace is the namespace of the personal APIs, whose params
+	// may contain passwords, so the commands are never kept in history.
+	sensitiveNamespace = "personal."
+)
+
+// terminalIO is the terminal input and output, which could be muted when
+// the history is replayed into terminal.
+type terminalIO struct {
+	io.Reader
+	out   io.Writer
+	lock  sync.Mutex
+	muted bool
+}
+
+func (t *terminalIO) Write(p []byte) (int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.muted {
+		return len(p), nil
+	}
+
+	return t.out.Write(p)
+}
+
+func (t *terminalIO) setMuted(muted bool) {
+	t.lock.Lock()
+	t.muted = muted
+	t.lock.Unlock()
+}
+
+// Interactive reads and executes commands from stdin until "exit" or EOF, with tab
+// completion of command names, and the history persisted in the specified file.
+// Commands are executed as script if stdin is not a terminal.
+func (c *Console) Interactive(historyFile string) error {
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return c.RunScript(os.Stdin)
+	}
+
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer terminal.Restore(fd, state)
+
+	history := loadHistory(historyFile)
+
+	// replay the history into terminal, so that it could be navigated with up and down keys
+	var replay strings.Builder
+	for _, line := range history {
+		replay.WriteString(line + "\r")
+	}
+
+	tio := &terminalIO{
+		Reader: io.MultiReader(strings.NewReader(replay.String()), os.Stdin),
+		out:    os.Stdout,
+		muted:  true,
+	}
+
+	term := terminal.NewTerminal(tio, prompt)
+	for range history {
+		if _, err = term.ReadLine(); err != nil {
+			return err
+		}
+	}
+
+	tio.setMuted(false)
+
+	if width, height, err := terminal.GetSize(fd); err == nil {
+		term.SetSize(width, height)
+	}
+
+	term.AutoCompleteCallback = c.autoComplete
+	c.out = term
+	c.readPassword = term.ReadPassword
+
+	if err = os.MkdirAll(filepath.Dir(historyFile), 0700); err != nil {
+		return err
+	}
+
+	historyWriter, err := os.OpenFile(historyFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer historyWriter.Close()
+
+	for {
+		line, err := term.ReadLine()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil && err != terminal.ErrPasteIndicator {
+			return err
+		}
+
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		appendHistory(historyWriter, line)
+
+		if err = c.Execute(line); err == errExit {
+			return nil
+		} else if err != nil {
+			term.Write([]byte(err.Error() + "\n"))
+		}
+	}
+}
+
+// autoComplete completes the command name with tab key.
+func (c *Console) autoComplete(line string, pos int, key rune) (newLine string, newPos int, ok bool) {
+	if key != '\t' || strings.ContainsAny(line[:pos], " \t") {
+		return "", 0, false
+	}
+
+	completions := c.Completions(line[:pos])
+	if len(completions) == 0 {
+		return "", 0, false
+	}
+
+	completed := commonPrefix(completions)
+	if len(completions) == 1 {
+		completed += " "
+	}
+
+	return completed + line[pos:], len(completed), true
+}
+
+// commonPrefix returns the longest common prefix of the strings.
+func commonPrefix(strs []string) string {
+	prefix := strs[0]
+	for _, s := range strs[1:] {
+		for !strings.HasPrefix(s, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
+
+// isSensitive returns whether the command may contain passwords, e.g. the personal
+// APIs or any params with password field, which should not be kept in history.
+func isSensitive(line string) bool {
+	line = strings.ToLower(line)
+	return strings.HasPrefix(line, sensitiveNamespace) || strings.Contains(line, "password")
+}
+
+// appendHistory writes the command to history file unless it is sensitive.
+func appendHistory(w io.Writer, line string) {
+	if !isSensitive(line) {
+		io.WriteString(w, line+"\n")
+	}
+}
+
+// loadHistory returns the latest commands in the history file, and the file
+// is truncated to keep the latest commands only. The sensitive commands saved
+// by earlier versions are removed from the file as well.
+func loadHistory(historyFile string) []string {
+	file, err := os.Open(historyFile)
+	if err != nil {
+		return nil
+	}
+
+	var history []string
+	sensitive := false
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line == "" {
+			continue
+		} else if isSensitive(line) {
+			sensitive = true
+		} else {
+			history = append(history, line)
+		}
+	}
+	file.Close()
+
+	if len(history) > maxHistory {
+		history = history[len(history)-maxHistory:]
+		sensitive = true
+	}
+
+	if sensitive {
+		ioutil.WriteFile(historyFile, []byte(strings.Join(history, "\n")+"\n"), 0600)
+	}
+
+	return history
+}
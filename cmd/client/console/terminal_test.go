@@ -0,0 +1,57 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package console
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_History_Sensitive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "console")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	historyFile := filepath.Join(dir, "history")
+	file, err := os.OpenFile(historyFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lines := []string{
+		"seele.getblockheight",
+		`personal.unlock {"Address": "0x01", "Password": "secret1"}`,
+		`Personal.NewAccount "secret2"`,
+		`personal.sendtransaction {"From": "0x01", "Password": "secret3"}`,
+		`wallet.import {"password": "secret4"}`,
+		"seele.getbalance 0x01",
+	}
+
+	for _, line := range lines {
+		appendHistory(file, line)
+	}
+	file.Close()
+
+	content, err := ioutil.ReadFile(historyFile)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, strings.Contains(string(content), "secret"), false)
+	assert.Equal(t, loadHistory(historyFile), []string{"seele.getblockheight", "seele.getbalance 0x01"})
+
+	// the sensitive lines saved before are removed when loaded
+	ioutil.WriteFile(historyFile, []byte(strings.Join(lines, "\n")+"\n"), 0600)
+	assert.Equal(t, loadHistory(historyFile), []string{"seele.getblockheight", "seele.getbalance 0x01"})
+
+	content, err = ioutil.ReadFile(historyFile)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, strings.Contains(string(content), "secret"), false)
+}
@@ -0,0 +1,80 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package comm
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+// The sources to read the private key from, so that the key need not be
+// specified in command line or stored in plaintext.
+const (
+	// KeySourceStdin reads the private key in HEX from stdin without echo.
+	KeySourceStdin = "stdin"
+
+	// KeySourceFd is the prefix to read the private key in HEX from the file
+	// descriptor, e.g. fd:3, which is usually a pipe opened by the caller.
+	KeySourceFd = "fd:"
+
+	// KeySourceKeystore is the prefix to read the private key from the encrypted
+	// keystore file, e.g. keystore:<file>, and the password is asked interactively.
+	KeySourceKeystore = "keystore:"
+)
+
+// KeySourceUsage is the usage of the flags to specify the key source.
+const KeySourceUsage = "source of the private key, stdin, fd:<N> or keystore:<file>"
+
+// ReadPrivateKey reads the private key from the source, see KeySourceStdin,
+// KeySourceFd and KeySourceKeystore.
+func ReadPrivateKey(source string) (*ecdsa.PrivateKey, error) {
+	switch {
+	case source == KeySourceStdin:
+		key, err := common.GetPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		return crypto.LoadECDSAFromString(key)
+	case strings.HasPrefix(source, KeySourceFd):
+		fd, err := strconv.ParseUint(strings.TrimPrefix(source, KeySourceFd), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid file descriptor in key source %s", source)
+		}
+
+		file := os.NewFile(uintptr(fd), source)
+		defer file.Close()
+
+		content, err := ioutil.ReadAll(file)
+		if err != nil {
+			return nil, err
+		}
+
+		return crypto.LoadECDSAFromString(strings.TrimSpace(string(content)))
+	case strings.HasPrefix(source, KeySourceKeystore):
+		pass, err := common.GetPassword()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := keystore.GetKey(strings.TrimPrefix(source, KeySourceKeystore), pass)
+		if err != nil {
+			return nil, err
+		}
+
+		return key.PrivateKey, nil
+	default:
+		return nil, fmt.Errorf("invalid key source %s, it should be %s, %s<N> or %s<file>", source, KeySourceStdin, KeySourceFd, KeySourceKeystore)
+	}
+}
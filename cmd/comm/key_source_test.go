@@ -0,0 +1,43 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package comm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+func Test_ReadPrivateKey_Fd(t *testing.T) {
+	_, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writer.WriteString(hexutil.BytesToHex(crypto.FromECDSA(privateKey)) + "\n")
+	writer.Close()
+
+	key, err := ReadPrivateKey(fmt.Sprintf("%s%d", KeySourceFd, reader.Fd()))
+	assert.Equal(t, err, nil)
+	assert.Equal(t, crypto.FromECDSA(key), crypto.FromECDSA(privateKey))
+}
+
+func Test_ReadPrivateKey_InvalidSource(t *testing.T) {
+	_, err := ReadPrivateKey("key")
+	assert.Equal(t, err != nil, true)
+
+	_, err = ReadPrivateKey(KeySourceFd + "abc")
+	assert.Equal(t, err != nil, true)
+}
@@ -6,11 +6,14 @@
 package cmd
 
 import (
+	"crypto/ecdsa"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 
 	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
 	"github.com/seeleteam/go-seele/core"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/log/comm"
@@ -37,6 +40,9 @@ type Config struct {
 	// The configuration of websocket rpc service
 	WSServerConfig rpc.WSServerConfig `json:"wsserver"`
 
+	// The limits of requests on all rpc services
+	RPCLimits rpc.LimiterConfig `json:"rpcLimits"`
+
 	// metrics config info
 	MetricsConfig metrics.Config `json:"metrics"`
 
@@ -56,13 +62,16 @@ func GetConfigFromFile(filepath string) (*Config, error) {
 	return &config, err
 }
 
-// LoadConfigFromFile gets node config from the given file
-func LoadConfigFromFile(configFile string) (*node.Config, error) {
+// LoadConfigFromFile gets node config from the given file. The p2p private key
+// is loaded from the config if the specified key is nil, see GetP2pConfig.
+func LoadConfigFromFile(configFile string, p2pKey *ecdsa.PrivateKey) (*node.Config, error) {
 	cmdConfig, err := GetConfigFromFile(configFile)
 	if err != nil {
 		return nil, err
 	}
 
+	cmdConfig.P2PConfig.PrivateKey = p2pKey
+
 	config := CopyConfig(cmdConfig)
 
 	config.P2PConfig, err = GetP2pConfig(cmdConfig)
@@ -86,6 +95,7 @@ func CopyConfig(cmdConfig *Config) *node.Config {
 		LogConfig:      cmdConfig.LogConfig,
 		HTTPServer:     cmdConfig.HTTPServer,
 		WSServerConfig: cmdConfig.WSServerConfig,
+		RPCLimits:      cmdConfig.RPCLimits,
 		P2PConfig:      cmdConfig.P2PConfig,
 		SeeleConfig:    node.SeeleConfig{},
 		MetricsConfig:  cmdConfig.MetricsConfig,
@@ -93,14 +103,47 @@ func CopyConfig(cmdConfig *Config) *node.Config {
 	return config
 }
 
-// GetP2pConfig get P2PConfig from the given config
+// GetP2pConfig get P2PConfig from the given config. The private key is loaded from the
+// plaintext privateKey if specified, otherwise decrypted from the node key file.
 func GetP2pConfig(cmdConfig *Config) (p2p.Config, error) {
-	if cmdConfig.P2PConfig.PrivateKey == nil {
+	if cmdConfig.P2PConfig.PrivateKey != nil {
+		return cmdConfig.P2PConfig, nil
+	}
+
+	if len(cmdConfig.P2PConfig.SubPrivateKey) > 0 {
 		key, err := crypto.LoadECDSAFromString(cmdConfig.P2PConfig.SubPrivateKey) // GetP2pConfigPrivateKey get privateKey from the given config
 		if err != nil {
 			return cmdConfig.P2PConfig, err
 		}
 		cmdConfig.P2PConfig.PrivateKey = key
+		return cmdConfig.P2PConfig, nil
+	}
+
+	file := GetNodeKeyFile(cmdConfig)
+	if !common.FileOrFolderExists(file) {
+		return cmdConfig.P2PConfig, fmt.Errorf("no p2p private key in config, and node key file %s not found, generate it with node key", file)
+	}
+
+	pass, err := common.GetPassword()
+	if err != nil {
+		return cmdConfig.P2PConfig, err
 	}
+
+	key, err := keystore.GetKey(file, pass)
+	if err != nil {
+		return cmdConfig.P2PConfig, fmt.Errorf("failed to decrypt node key file %s: %s", file, err.Error())
+	}
+
+	cmdConfig.P2PConfig.PrivateKey = key.PrivateKey
 	return cmdConfig.P2PConfig, nil
 }
+
+// GetNodeKeyFile returns the node key file specified in config,
+// or the default one in the node data folder.
+func GetNodeKeyFile(cmdConfig *Config) string {
+	if len(cmdConfig.P2PConfig.KeyFile) > 0 {
+		return cmdConfig.P2PConfig.KeyFile
+	}
+
+	return node.NodeKeyFile(filepath.Join(common.GetDefaultDataFolder(), cmdConfig.BasicConfig.DataDir))
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/node"
 )
 
 func Test_LoadConfigFromFile(t *testing.T) {
@@ -14,7 +16,7 @@ func Test_LoadConfigFromFile(t *testing.T) {
 	assert.Equal(t, err, nil, "1")
 	configFilePath := filepath.Join(currentProjectPath, configFileName)
 
-	config, err := LoadConfigFromFile(configFilePath)
+	config, err := LoadConfigFromFile(configFilePath, nil)
 
 	assert.Equal(t, err, nil, "2")
 	assert.Equal(t, config.BasicConfig.Name, "seele node2", "3")
@@ -37,3 +39,22 @@ func Test_LoadConfigFromFile(t *testing.T) {
 	assert.Equal(t, config.SeeleConfig.GenesisConfig.Difficult, int64(22), "15")
 	assert.Equal(t, config.SeeleConfig.GenesisConfig.ShardNumber, uint(12), "16")
 }
+
+func Test_GetP2pConfig_NodeKeyFile(t *testing.T) {
+	cmdConfig := &Config{}
+	cmdConfig.BasicConfig.DataDir = "node1"
+	assert.Equal(t, GetNodeKeyFile(cmdConfig), node.NodeKeyFile(filepath.Join(common.GetDefaultDataFolder(), "node1")))
+
+	// no private key and node key file
+	cmdConfig.P2PConfig.KeyFile = filepath.Join(os.TempDir(), "seele-nodekey-not-exist")
+	assert.Equal(t, GetNodeKeyFile(cmdConfig), cmdConfig.P2PConfig.KeyFile)
+
+	_, err := GetP2pConfig(cmdConfig)
+	assert.Equal(t, err != nil, true)
+
+	// plaintext private key
+	cmdConfig.P2PConfig.SubPrivateKey = "0x66bfaadbbade123f0dde5c35ec7053f88027ce3ea2f7f0296b99a5e87de6dea7"
+	config, err := GetP2pConfig(cmdConfig)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, config.PrivateKey != nil, true)
+}
@@ -0,0 +1,95 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/seeleteam/go-seele/cmd/comm"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/spf13/cobra"
+)
+
+var nodeKeyConfigFile string
+var nodeKeyFile string
+var nodeKeyImport bool
+var nodeKeySource string
+
+// keyCmd represents the command to generate and store the encrypted node key
+var keyCmd = &cobra.Command{
+	Use:   "key",
+	Short: "generate and store the encrypted node key",
+	Long: `generate the p2p private key as the node identity, and store it encrypted with password
+  to the node key file, which is used when the node starts if no privateKey in config. The key
+  file is the keyFile in p2p config, or nodekey in the node data folder by default. Use client key
+  to generate the key pairs of accounts.
+  For example:
+    node.exe key -c cmd\node.json
+    node.exe key -f nodekey
+    node.exe key -c cmd\node.json --import --keyfrom stdin`,
+	Run: func(cmd *cobra.Command, args []string) {
+		file := nodeKeyFile
+		if len(file) == 0 {
+			if len(nodeKeyConfigFile) == 0 {
+				fmt.Println("either --config or --file should be specified")
+				return
+			}
+
+			cmdConfig, err := GetConfigFromFile(nodeKeyConfigFile)
+			if err != nil {
+				fmt.Printf("reading the config file failed: %s\n", err.Error())
+				return
+			}
+
+			file = GetNodeKeyFile(cmdConfig)
+		}
+
+		if common.FileOrFolderExists(file) {
+			fmt.Printf("node key file %s already exists\n", file)
+			return
+		}
+
+		var privateKey *ecdsa.PrivateKey
+		var err error
+		if nodeKeyImport {
+			privateKey, err = comm.ReadPrivateKey(nodeKeySource)
+		} else {
+			_, privateKey, err = crypto.GenerateKeyPair()
+		}
+
+		if err != nil {
+			fmt.Printf("getting the private key failed: %s\n", err.Error())
+			return
+		}
+
+		pass, err := common.SetPassword()
+		if err != nil {
+			fmt.Printf("get password failed: %s\n", err.Error())
+			return
+		}
+
+		key := &keystore.Key{Address: *crypto.MustGetAddress(privateKey), PrivateKey: privateKey}
+		if err = keystore.StoreKey(file, pass, key); err != nil {
+			fmt.Printf("storing the node key failed: %s\n", err.Error())
+			return
+		}
+
+		fmt.Printf("node key file: %s\n", file)
+		fmt.Printf("public key: %s\n", key.Address.ToHex())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(keyCmd)
+
+	keyCmd.Flags().StringVarP(&nodeKeyConfigFile, "config", "c", "", "seele node config file to locate the node key file")
+	keyCmd.Flags().StringVarP(&nodeKeyFile, "file", "f", "", "node key file, instead of the one located by config")
+	keyCmd.Flags().BoolVar(&nodeKeyImport, "import", false, "whether to import the existing private key instead of generating one")
+	keyCmd.Flags().StringVar(&nodeKeySource, "keyfrom", comm.KeySourceStdin, "source of the imported private key, stdin, fd:<N> or keystore:<file>")
+}
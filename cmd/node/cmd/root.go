@@ -9,7 +9,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/seeleteam/go-seele/cmd/comm"
 	"github.com/spf13/cobra"
 )
 
@@ -36,5 +35,4 @@ func Execute() {
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&rpcAddr, "addr", "a", "127.0.0.1:55027", "rpc address")
-	rootCmd.AddCommand(comm.GetGenerateKeyPairCmd("node"))
 }
@@ -7,10 +7,12 @@ package cmd
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"strings"
 	"sync"
 
+	"github.com/seeleteam/go-seele/cmd/comm"
 	"github.com/seeleteam/go-seele/log"
 	"github.com/seeleteam/go-seele/metrics"
 	"github.com/seeleteam/go-seele/monitor"
@@ -22,6 +24,7 @@ import (
 var seeleNodeConfigFile *string
 var miner *string
 var metricsEnableFlag *bool
+var p2pKeySource string
 
 // startCmd represents the start command
 var startCmd = &cobra.Command{
@@ -29,11 +32,24 @@ var startCmd = &cobra.Command{
 	Short: "start the node of seele",
 	Long: `usage example:
 		node.exe start -c cmd\node.json
-		start a node.`,
+		node.exe start -c cmd\node.json --p2pkey fd:3 3<nodekey
+		start a node. The p2p private key is loaded from the p2p key source if specified,
+		otherwise the privateKey in config, or the node key file generated with node key.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
 		var wg sync.WaitGroup
-		nCfg, err := LoadConfigFromFile(*seeleNodeConfigFile)
+		var p2pKey *ecdsa.PrivateKey
+		if len(p2pKeySource) > 0 {
+			key, err := comm.ReadPrivateKey(p2pKeySource)
+			if err != nil {
+				fmt.Printf("reading the p2p private key failed: %s\n", err.Error())
+				return
+			}
+
+			p2pKey = key
+		}
+
+		nCfg, err := LoadConfigFromFile(*seeleNodeConfigFile, p2pKey)
 		if err != nil {
 			fmt.Printf("reading the config file failed: %s\n", err.Error())
 			return
@@ -109,4 +125,6 @@ func init() {
 	miner = startCmd.Flags().StringP("miner", "m", "start", "miner start or not, [start, stop]")
 
 	metricsEnableFlag = startCmd.Flags().BoolP("metrics", "t", false, "start metrics")
+
+	startCmd.Flags().StringVar(&p2pKeySource, "p2pkey", "", "source of the p2p private key instead of config, stdin, fd:<N> or keystore:<file>")
 }
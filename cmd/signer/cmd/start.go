@@ -0,0 +1,93 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/accounts/signer"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	addr              string
+	keystoreDir       string
+	maxAmount         string
+	allowedRecipients []string
+)
+
+// startCmd represents the command to start the signer daemon
+var startCmd = &cobra.Command{
+	Use:   "start",
+	Short: "start the external signer daemon",
+	Long: `start the external signer daemon of the key files in keystore folder, which serves the
+  signer APIs to the node and client. Each request is approved on this terminal with the
+  password of account, and the transactions are checked against the rules first.
+  For example:
+    signer start
+    signer start --addr 127.0.0.1:55030 --keystore keystore --maxamount 1000 --allow 0x<address1>,0x<address2>`,
+	Run: func(cmd *cobra.Command, args []string) {
+		rules, err := getRules()
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+
+		fmt.Printf("keystore folder: %s\n", keystoreDir)
+		fmt.Printf("signer listening on %s\n", listener.Addr())
+
+		api := signer.NewAPI(keystoreDir, &terminalUI{os.Stdin, os.Stdout}, rules)
+		if err = signer.Serve(listener, api); err != nil {
+			fmt.Println(err.Error())
+		}
+	},
+}
+
+// getRules gets the rules of transactions from flags.
+func getRules() (*signer.Rules, error) {
+	rules := &signer.Rules{}
+
+	if len(maxAmount) > 0 {
+		amount, ok := big.NewInt(0).SetString(maxAmount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("invalid max amount %s", maxAmount)
+		}
+
+		rules.MaxAmount = amount
+	}
+
+	for _, recipient := range allowedRecipients {
+		address, err := common.HexToAddress(recipient)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed recipient %s: %s", recipient, err.Error())
+		}
+
+		rules.AllowedRecipients = append(rules.AllowedRecipients, address)
+	}
+
+	return rules, nil
+}
+
+func init() {
+	rootCmd.AddCommand(startCmd)
+
+	startCmd.Flags().StringVarP(&addr, "addr", "a", "127.0.0.1:55030", "TCP address to serve the signer APIs")
+	startCmd.Flags().StringVar(&keystoreDir, "keystore", accounts.KeystoreDir(filepath.Join(common.GetDefaultDataFolder(), "signer")), "keystore folder of the accounts")
+	startCmd.Flags().StringVar(&maxAmount, "maxamount", "", "max amount of a transaction, no limit if empty")
+	startCmd.Flags().StringSliceVar(&allowedRecipients, "allow", nil, "comma separated addresses of allowed recipients, any recipient if empty")
+}
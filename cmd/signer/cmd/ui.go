@@ -0,0 +1,93 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/seeleteam/go-seele/accounts/signer"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/core/types"
+)
+
+// terminalUI asks the user of signer terminal to approve the requests.
+type terminalUI struct {
+	in  io.Reader
+	out io.Writer
+}
+
+// ApproveTx implements signer.UI.
+func (ui *terminalUI) ApproveTx(tx *types.Transaction) (string, error) {
+	to := "contract creation"
+	if tx.Data.To != nil {
+		to = tx.Data.To.ToHex()
+	}
+
+	fmt.Fprintln(ui.out, "\n------- request to sign transaction -------")
+	fmt.Fprintf(ui.out, "from:    %s\n", tx.Data.From.ToHex())
+	fmt.Fprintf(ui.out, "to:      %s\n", to)
+	fmt.Fprintf(ui.out, "amount:  %s\n", tx.Data.Amount)
+	fmt.Fprintf(ui.out, "fee:     %s\n", tx.Data.Fee)
+	fmt.Fprintf(ui.out, "nonce:   %d\n", tx.Data.AccountNonce)
+	fmt.Fprintf(ui.out, "payload: %s\n", hexutil.BytesToHex(tx.Data.Payload))
+
+	return ui.approve()
+}
+
+// ApproveMessage implements signer.UI.
+func (ui *terminalUI) ApproveMessage(address common.Address, message []byte) (string, error) {
+	fmt.Fprintln(ui.out, "\n------- request to sign message -------")
+	fmt.Fprintf(ui.out, "address: %s\n", address.ToHex())
+	fmt.Fprintf(ui.out, "message: %q\n", message)
+
+	return ui.approve()
+}
+
+// approve asks the user to approve the request, and returns the password of account.
+func (ui *terminalUI) approve() (string, error) {
+	fmt.Fprint(ui.out, "Approve the request? [y/N]: ")
+
+	answer, err := ui.readLine()
+	if err != nil {
+		return "", err
+	}
+
+	if answer = strings.ToLower(strings.TrimSpace(answer)); answer != "y" && answer != "yes" {
+		fmt.Fprintln(ui.out, "rejected")
+		return "", signer.ErrRejected
+	}
+
+	return common.GetPassword()
+}
+
+// readLine reads a line byte by byte without buffering, so that
+// the password could be read from the same input later.
+func (ui *terminalUI) readLine() (string, error) {
+	var line []byte
+	buf := make([]byte, 1)
+
+	for {
+		n, err := ui.in.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				return string(line), nil
+			}
+
+			line = append(line, buf[0])
+		}
+
+		if err == io.EOF && len(line) > 0 {
+			return string(line), nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+}
@@ -7,16 +7,25 @@ package common
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/crypto/sha3"
 )
 
 const (
 	addressIDBits = 512 // the length of the public key
+
+	// ShortAddressLength is the length of the short address, which is derived from
+	// the hash of public key, and stored in the first bytes of Address.
+	ShortAddressLength = 20
 )
 
+var errAddressChecksum = errors.New("invalid address checksum")
+
 // Address we use public key as node id
 type Address [addressIDBits / 8]byte
 
@@ -35,28 +44,94 @@ func (id Address) Bytes() []byte {
 	return id[:]
 }
 
+// NewShortAddress converts the bytes of the public key hash to a short address.
+func NewShortAddress(b []byte) (Address, error) {
+	var id Address
+	if len(b) != ShortAddressLength {
+		return id, fmt.Errorf("wrong length, want %d bytes", ShortAddressLength)
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+// IsShort returns true if it is a short address, of which the bytes
+// after ShortAddressLength are all zero.
+func (id Address) IsShort() bool {
+	var empty Address
+	return !bytes.Equal(id[:ShortAddressLength], empty[:ShortAddressLength]) && bytes.Equal(id[ShortAddressLength:], empty[ShortAddressLength:])
+}
+
+// ToHex returns the address in HEX encoded in mixed case with checksum,
+// so that the typo could be detected when converted back to address.
 func (id *Address) ToHex() string {
-	return hexutil.BytesToHex(id.Bytes())
+	if id.IsShort() {
+		return checksumHex(id[:ShortAddressLength])
+	}
+
+	return checksumHex(id.Bytes())
 }
 
 func (id *Address) Equal(b Address) bool {
 	return bytes.Equal(id[:], b[:])
 }
 
+// HexToAddress converts the HEX string to address. The checksum is validated
+// if in mixed case, and the HEX string in lower or upper case has no checksum.
 func HexToAddress(id string) (Address, error) {
 	byte, err := hexutil.HexToBytes(id)
 	if err != nil {
 		return Address{}, err
 	}
 
-	nid, err := NewAddress(byte)
+	var nid Address
+	if len(byte) == ShortAddressLength {
+		nid, err = NewShortAddress(byte)
+	} else {
+		nid, err = NewAddress(byte)
+	}
+
 	if err != nil {
 		return Address{}, err
 	}
 
+	digits := id[2:]
+	mixedCase := digits != strings.ToLower(digits) && digits != strings.ToUpper(digits)
+	if mixedCase && checksumHex(byte)[2:] != digits {
+		return Address{}, errAddressChecksum
+	}
+
 	return nid, nil
 }
 
+// checksumHex encodes the bytes in HEX with mixed case checksum, the letter is
+// in upper case if the corresponding nibble of the hash of lower case HEX is >= 8.
+// The short address is hashed with keccak256 as EIP-55, and keccak512 is used for
+// the long address, so that every HEX digit has a nibble of hash.
+func checksumHex(b []byte) string {
+	digits := []byte(hexutil.BytesToHex(b)[2:])
+
+	d := sha3.NewKeccak256()
+	if len(b) > ShortAddressLength {
+		d = sha3.NewKeccak512()
+	}
+
+	d.Write(digits)
+	hash := d.Sum(nil)
+
+	for i, c := range digits {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+
+		if c >= 'a' && nibble >= 8 {
+			digits[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(digits)
+}
+
 func HexMustToAddres(id string) Address {
 	a, err := HexToAddress(id)
 	if err != nil {
@@ -7,6 +7,7 @@ package common
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/magiconair/properties/assert"
@@ -44,3 +45,91 @@ func Test_JsonMarshal(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, result.Bytes(), addr.Bytes())
 }
+
+func Test_ShortAddress(t *testing.T) {
+	b := make([]byte, ShortAddressLength)
+	for i := range b {
+		b[i] = byte(i*13 + 7)
+	}
+
+	addr, err := NewShortAddress(b)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, addr.IsShort(), true)
+	assert.Equal(t, addr.Bytes()[:ShortAddressLength], b)
+
+	// encoded with checksum
+	hex := addr.ToHex()
+	assert.Equal(t, len(hex), 2+ShortAddressLength*2)
+	assert.Equal(t, hex != strings.ToLower(hex), true)
+
+	decoded, err := HexToAddress(hex)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decoded, addr)
+
+	// same checksum as EIP-55
+	known, err := HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, known.ToHex(), "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+
+	// checksum is not validated in single case
+	decoded, err = HexToAddress(strings.ToLower(hex))
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decoded, addr)
+
+	// invalid checksum
+	_, err = HexToAddress(flipFirstLetterCase(hex))
+	assert.Equal(t, err, errAddressChecksum)
+
+	// long address and small address are not short
+	assert.Equal(t, HexMustToAddres("0x1826603c48b4460a90af24f2d0c549b022f5a17a8f50a4a448d20ba579d01781efd18ad6b2fb90fe81207338fb0b0d6c1b6012df19c087cd8bb0e255e0c1711e").IsShort(), false)
+	assert.Equal(t, BytesToAddress([]byte{1}).IsShort(), false)
+	assert.Equal(t, Address{}.IsShort(), false)
+}
+
+func flipFirstLetterCase(hex string) string {
+	b := []byte(hex)
+	for i := 2; i < len(b); i++ {
+		if b[i] >= 'a' && b[i] <= 'f' {
+			b[i] = b[i] - 'a' + 'A'
+			break
+		} else if b[i] >= 'A' && b[i] <= 'F' {
+			b[i] = b[i] - 'A' + 'a'
+			break
+		}
+	}
+
+	return string(b)
+}
+
+func Test_AddressChecksum(t *testing.T) {
+	lower := "0x1826603c48b4460a90af24f2d0c549b022f5a17a8f50a4a448d20ba579d01781efd18ad6b2fb90fe81207338fb0b0d6c1b6012df19c087cd8bb0e255e0c1711e"
+	addr := HexMustToAddres(lower)
+
+	// encoded with checksum
+	hex := addr.ToHex()
+	assert.Equal(t, strings.ToLower(hex), lower)
+	assert.Equal(t, hex != lower, true)
+
+	decoded, err := HexToAddress(hex)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decoded, addr)
+
+	// checksum is not validated in single case
+	decoded, err = HexToAddress("0x" + strings.ToUpper(lower[2:]))
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decoded, addr)
+
+	// invalid checksum
+	_, err = HexToAddress(flipFirstLetterCase(hex))
+	assert.Equal(t, err, errAddressChecksum)
+
+	// typo of digit
+	typo := []byte(hex)
+	typo[2] = '2'
+	_, err = HexToAddress(string(typo))
+	assert.Equal(t, err, errAddressChecksum)
+
+	// wrong length is not a checksum error
+	_, err = HexToAddress(hex[:len(hex)-2])
+	assert.Equal(t, err != nil && err != errAddressChecksum, true)
+}
@@ -6,24 +6,101 @@
 package keystore
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+
+	"github.com/seeleteam/go-seele/common"
 )
 
-// GetKey get private key from a file
+// GetKey get private key from a file. The legacy key file is migrated to the current
+// version once decrypted, and the key is still returned if failed to migrate.
 func GetKey(fileName, password string) (*Key, error) {
 	content, err := ioutil.ReadFile(fileName)
 	if err != nil {
 		return nil, err
 	}
 
-	return DecryptKey(content, password)
+	key, err := DecryptKey(content, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if params, version, err := getParams(content); err == nil && version < Version {
+		StoreKeyWithParams(fileName, password, key, params)
+	}
+
+	return key, nil
+}
+
+// ChangePassword re-encrypts the key file with the new password and the same KDF parameters.
+func ChangePassword(fileName, password, newPassword string) (*Key, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := DecryptKey(content, password)
+	if err != nil {
+		return nil, err
+	}
+
+	params, _, err := getParams(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = StoreKeyWithParams(fileName, newPassword, key, params); err != nil {
+		return nil, err
+	}
+
+	return key, nil
 }
 
-// StoreKey store private key in a file. Note it is not encrypted. Need to support it later.
+// Upgrade re-encrypts the key file of any supported version in the current version
+// with the specified KDF parameters.
+func Upgrade(fileName, password string, params Params) (*Key, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := DecryptKey(content, password)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = StoreKeyWithParams(fileName, password, key, params); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// GetAddress gets the address of the key file without decryption.
+func GetAddress(fileName string) (common.Address, error) {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	k := new(encryptedKey)
+	if err = json.Unmarshal(content, k); err != nil {
+		return common.Address{}, err
+	}
+
+	return common.HexToAddress(k.Address)
+}
+
+// StoreKey store private key in a file encrypted with the standard parameters.
 func StoreKey(fileName, password string, key *Key) error {
-	content, err := EncryptKey(key, password)
+	return StoreKeyWithParams(fileName, password, key, StandardParams)
+}
+
+// StoreKeyWithParams store private key in a file encrypted with the specified KDF parameters.
+func StoreKeyWithParams(fileName, password string, key *Key, params Params) error {
+	content, err := EncryptKeyWithParams(key, password, params)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ package keystore
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -39,4 +40,59 @@ func Test_KeyStore(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, crypto.FromECDSA(key.PrivateKey), crypto.FromECDSA(result.PrivateKey))
 	assert.Equal(t, key.Address, result.Address)
+
+	address, err := GetAddress(fileName)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, address, key.Address)
+}
+
+func Test_KeyStore_Migrate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+
+	addr, keypair, err := crypto.GenerateKeyPair()
+	if err != nil {
+		panic(err)
+	}
+
+	fileName := filepath.Join(dir, "keyfile")
+	err = ioutil.WriteFile(fileName, encryptKeyV1(&Key{*addr, keypair}, "pass"), 0600)
+	assert.Equal(t, err, nil)
+
+	// migrated to current version once decrypted
+	_, err = GetKey(fileName, "pass")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, getFileParams(fileName), StandardParams)
+
+	_, err = Upgrade(fileName, "pass", LightParams)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, getFileParams(fileName), LightParams)
+
+	// keeps the KDF parameters with the new password
+	_, err = ChangePassword(fileName, "badpass", "newpass")
+	assert.Equal(t, err, ErrDecrypt)
+	_, err = ChangePassword(fileName, "pass", "newpass")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, getFileParams(fileName), LightParams)
+
+	key, err := GetKey(fileName, "newpass")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, key.Address, *addr)
+}
+
+func getFileParams(fileName string) Params {
+	content, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		panic(err)
+	}
+
+	params, version, err := getParams(content)
+	if err != nil || version != Version {
+		panic("invalid key file version")
+	}
+
+	return params
 }
@@ -9,6 +9,7 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/crypto"
+	"golang.org/x/crypto/pbkdf2"
 	"golang.org/x/crypto/scrypt"
 )
 
@@ -27,24 +29,104 @@ const (
 	ScryptP     = 1
 	scryptR     = 8
 	scryptDKLen = 32
+
+	// LightScryptN and LightScryptP are the scrypt parameters of light strength,
+	// which use less memory and CPU, e.g. for mobile devices.
+	LightScryptN = 1 << 12
+	LightScryptP = 6
+
+	// PBKDF2C and LightPBKDF2C are the pbkdf2 iteration counts of standard and light strength.
+	PBKDF2C      = 1 << 18
+	LightPBKDF2C = 1 << 13
+
+	maxScryptN  = 1 << 20
+	maxScryptRP = 16
+	maxPBKDF2C  = 1 << 24
+)
+
+// KDF names
+const (
+	KDFScrypt = "scrypt"
+	KDFPBKDF2 = "pbkdf2"
+
+	cipherAES128CTR = "aes-128-ctr"
+	prfHMACSHA256   = "hmac-sha256"
 )
 
 var (
 	// ErrDecrypt error when the passphrase is not right
 	ErrDecrypt = errors.New("could not decrypt key with given passphrase")
+
+	errInvalidKDF       = errors.New("invalid KDF, it should be scrypt or pbkdf2")
+	errInvalidKDFParams = errors.New("invalid KDF parameters")
+	errInvalidCipher    = errors.New("unsupported cipher")
 )
 
-// EncryptKey encrypts a key using the specified scrypt parameters into a json
-// passphrase -> script function -> decryption key
-// decryption key + private key ->  aes-128-ctr algorithm -> encrypted private key
+// Params is the KDF and its parameters to encrypt the key.
+type Params struct {
+	KDF     string
+	ScryptN int
+	ScryptP int
+	PBKDF2C int
+}
+
+var (
+	// StandardParams is the default params with scrypt of standard strength.
+	StandardParams = Params{KDF: KDFScrypt, ScryptN: ScryptN, ScryptP: ScryptP}
+
+	// LightParams is the params with scrypt of light strength.
+	LightParams = Params{KDF: KDFScrypt, ScryptN: LightScryptN, ScryptP: LightScryptP}
+)
+
+// GetParams returns the params of the KDF with standard or light strength.
+func GetParams(kdf string, light bool) (Params, error) {
+	switch kdf {
+	case KDFScrypt:
+		if light {
+			return LightParams, nil
+		}
+
+		return StandardParams, nil
+	case KDFPBKDF2:
+		if light {
+			return Params{KDF: KDFPBKDF2, PBKDF2C: LightPBKDF2C}, nil
+		}
+
+		return Params{KDF: KDFPBKDF2, PBKDF2C: PBKDF2C}, nil
+	default:
+		return Params{}, errInvalidKDF
+	}
+}
+
+// EncryptKey encrypts a key using the standard scrypt parameters into a json
 func EncryptKey(key *Key, auth string) ([]byte, error) {
-	salt := getRandBuff(32)
-	scryptKey, err := getScryptKey(salt, auth)
+	return EncryptKeyWithParams(key, auth, StandardParams)
+}
+
+// EncryptKeyWithParams encrypts a key using the specified KDF parameters into a json
+// passphrase -> KDF -> decryption key
+// decryption key + private key ->  aes-128-ctr algorithm -> encrypted private key
+func EncryptKeyWithParams(key *Key, auth string, params Params) ([]byte, error) {
+	kdf := kdfParams{
+		DKLen: scryptDKLen,
+		Salt:  hex.EncodeToString(getRandBuff(32)),
+	}
+
+	switch params.KDF {
+	case KDFScrypt:
+		kdf.N, kdf.R, kdf.P = params.ScryptN, scryptR, params.ScryptP
+	case KDFPBKDF2:
+		kdf.C, kdf.PRF = params.PBKDF2C, prfHMACSHA256
+	default:
+		return nil, errInvalidKDF
+	}
+
+	derivedKey, err := getDerivedKey(params.KDF, &kdf, auth)
 	if err != nil {
 		return nil, err
 	}
 
-	encryptKey := scryptKey[:16]
+	encryptKey := derivedKey[:16]
 	keyBytes := math.PaddedBigBytes(key.PrivateKey.D, 32)
 
 	iv := getRandBuff(aes.BlockSize) // 16
@@ -53,30 +135,48 @@ func EncryptKey(key *Key, auth string) ([]byte, error) {
 		return nil, err
 	}
 
-	mac := crypto.HashBytes(scryptKey[16:32], cipherText)
+	mac := crypto.HashBytes(derivedKey[16:32], cipherText)
 	info := cryptoInfo{
-		CipherText: hex.EncodeToString(cipherText),
-		CipherIV:   hex.EncodeToString(iv),
-		Salt:       hex.EncodeToString(salt),
-		MAC:        mac.ToHex(),
+		Cipher:       cipherAES128CTR,
+		CipherText:   hex.EncodeToString(cipherText),
+		CipherParams: cipherParams{hex.EncodeToString(iv)},
+		KDF:          params.KDF,
+		KDFParams:    kdf,
+		MAC:          mac.ToHex(),
+	}
+
+	infoBytes, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
 	}
 
 	encryptedKey := encryptedKey{
-		Version:Version,
-		Address:key.Address.ToHex(),
-		Crypto:info,
+		Version: Version,
+		Address: key.Address.ToHex(),
+		Crypto:  infoBytes,
 	}
 
 	return json.MarshalIndent(encryptedKey, "", "\t")
 }
 
-// DecryptKey decrypts a key from a json blob, returning the private key itself.
+// DecryptKey decrypts a key from a json blob of any supported version, returning the private key itself.
 func DecryptKey(keyjson []byte, auth string) (*Key, error) {
 	k := new(encryptedKey)
 	if err := json.Unmarshal(keyjson, k); err != nil {
 		return nil, err
 	}
-	keyBytes, err := doDecrypt(k, auth)
+
+	var keyBytes []byte
+	var err error
+	switch k.Version {
+	case version1:
+		keyBytes, err = doDecryptV1(k, auth)
+	case Version:
+		keyBytes, err = doDecrypt(k, auth)
+	default:
+		return nil, fmt.Errorf("Version not supported: %v", k.Version)
+	}
+
 	// Handle any decryption errors and return the key
 	if err != nil {
 		return nil, err
@@ -91,59 +191,140 @@ func DecryptKey(keyjson []byte, auth string) (*Key, error) {
 		return nil, err
 	}
 
+	// keeps the short address of key file
+	if stored, err := common.HexToAddress(k.Address); err == nil && stored.IsShort() {
+		if shortAddr := crypto.GetShortAddress(key); shortAddr.Equal(stored) {
+			addr = shortAddr
+		}
+	}
+
 	return &Key{
 		Address:    *addr,
 		PrivateKey: key,
 	}, nil
 }
 
+// getParams returns the KDF params of the key json, and the legacy version uses the standard params.
+func getParams(keyjson []byte) (Params, int, error) {
+	k := new(encryptedKey)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return Params{}, 0, err
+	}
+
+	if k.Version != Version {
+		return StandardParams, k.Version, nil
+	}
+
+	info := new(cryptoInfo)
+	if err := json.Unmarshal(k.Crypto, info); err != nil {
+		return Params{}, 0, err
+	}
+
+	params := Params{
+		KDF:     info.KDF,
+		ScryptN: info.KDFParams.N,
+		ScryptP: info.KDFParams.P,
+		PBKDF2C: info.KDFParams.C,
+	}
+
+	return params, k.Version, nil
+}
+
 func doDecrypt(keyProtected *encryptedKey, auth string) ([]byte, error) {
-	if keyProtected.Version != Version {
-		return nil, fmt.Errorf("Version not supported: %v", keyProtected.Version)
+	info := new(cryptoInfo)
+	if err := json.Unmarshal(keyProtected.Crypto, info); err != nil {
+		return nil, err
+	}
+
+	if info.Cipher != cipherAES128CTR {
+		return nil, errInvalidCipher
+	}
+
+	iv, err := hex.DecodeString(info.CipherParams.IV)
+	if err != nil {
+		return nil, err
 	}
 
-	mac, err := common.HexToHash(keyProtected.Crypto.MAC)
+	derivedKey, err := getDerivedKey(info.KDF, &info.KDFParams, auth)
 	if err != nil {
 		return nil, err
 	}
 
-	iv, err := hex.DecodeString(keyProtected.Crypto.CipherIV)
+	return decryptCipherText(derivedKey, info.CipherText, iv, info.MAC)
+}
+
+func doDecryptV1(keyProtected *encryptedKey, auth string) ([]byte, error) {
+	info := new(cryptoInfoV1)
+	if err := json.Unmarshal(keyProtected.Crypto, info); err != nil {
+		return nil, err
+	}
+
+	iv, err := hex.DecodeString(info.CipherIV)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := hex.DecodeString(info.Salt)
 	if err != nil {
 		return nil, err
 	}
 
-	cipherText, err := hex.DecodeString(keyProtected.Crypto.CipherText)
+	scyptKey, err := scrypt.Key([]byte(auth), salt, ScryptN, scryptR, ScryptP, scryptDKLen)
 	if err != nil {
 		return nil, err
 	}
 
-	salt, err := hex.DecodeString(keyProtected.Crypto.Salt)
+	return decryptCipherText(scyptKey, info.CipherText, iv, info.MAC)
+}
+
+// decryptCipherText verifies the MAC with the derived key and decrypts the cipher text.
+func decryptCipherText(derivedKey []byte, cipherHex string, iv []byte, macHex string) ([]byte, error) {
+	mac, err := common.HexToHash(macHex)
 	if err != nil {
 		return nil, err
 	}
 
-	scyptKey, err := getScryptKey(salt, auth)
+	cipherText, err := hex.DecodeString(cipherHex)
 	if err != nil {
 		return nil, err
 	}
 
-	calculatedMAC := crypto.HashBytes(scyptKey[16:32], cipherText)
+	calculatedMAC := crypto.HashBytes(derivedKey[16:32], cipherText)
 	if !calculatedMAC.Equal(mac) {
 		return nil, ErrDecrypt
 	}
 
-	plainText, err := aesCTRXOR(scyptKey[:16], cipherText, iv)
+	return aesCTRXOR(derivedKey[:16], cipherText, iv)
+}
+
+// getDerivedKey uses the KDF to calculate the auth key. The params are validated
+// so that a key file could not make the KDF run out of memory or forever.
+func getDerivedKey(kdf string, params *kdfParams, auth string) ([]byte, error) {
+	salt, err := hex.DecodeString(params.Salt)
 	if err != nil {
 		return nil, err
 	}
 
-	return plainText, err
-}
+	if params.DKLen != scryptDKLen {
+		return nil, errInvalidKDFParams
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		if params.N <= 1 || params.N > maxScryptN || params.R <= 0 || params.R > maxScryptRP || params.P <= 0 || params.P > maxScryptRP {
+			return nil, errInvalidKDFParams
+		}
 
-// use scrypt to calculate auth key
-func getScryptKey(salt []byte, auth string) ([]byte, error) {
-	authArray := []byte(auth)
-	return scrypt.Key(authArray, salt, ScryptN, scryptR, ScryptP, scryptDKLen)
+		return scrypt.Key([]byte(auth), salt, params.N, params.R, params.P, params.DKLen)
+	case KDFPBKDF2:
+		if params.C <= 0 || params.C > maxPBKDF2C || params.PRF != prfHMACSHA256 {
+			return nil, errInvalidKDFParams
+		}
+
+		return pbkdf2.Key([]byte(auth), salt, params.C, params.DKLen, sha256.New), nil
+	default:
+		return nil, errInvalidKDF
+	}
 }
 
 // AES-128 is selected due to size of encryptKey.
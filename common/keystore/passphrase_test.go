@@ -6,10 +6,15 @@
 package keystore
 
 import (
+	"crypto/aes"
+	"encoding/hex"
+	"encoding/json"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/magiconair/properties/assert"
 	"github.com/seeleteam/go-seele/crypto"
+	"golang.org/x/crypto/scrypt"
 )
 
 func Test_PassPhrase(t *testing.T) {
@@ -35,3 +40,101 @@ func Test_PassPhrase(t *testing.T) {
 	_, err = DecryptKey(result, "badpass")
 	assert.Equal(t, err, ErrDecrypt)
 }
+
+func Test_PassPhrase_Params(t *testing.T) {
+	addr, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		panic(err)
+	}
+
+	key := &Key{*addr, privateKey}
+	for _, kdf := range []string{KDFScrypt, KDFPBKDF2} {
+		params, err := GetParams(kdf, true)
+		assert.Equal(t, err, nil)
+
+		result, err := EncryptKeyWithParams(key, "test", params)
+		assert.Equal(t, err, nil)
+
+		decryptKey, err := DecryptKey(result, "test")
+		assert.Equal(t, err, nil)
+		assert.Equal(t, crypto.FromECDSA(decryptKey.PrivateKey), crypto.FromECDSA(privateKey))
+
+		resultParams, version, err := getParams(result)
+		assert.Equal(t, err, nil)
+		assert.Equal(t, version, Version)
+		assert.Equal(t, resultParams, params)
+
+		_, err = DecryptKey(result, "badpass")
+		assert.Equal(t, err, ErrDecrypt)
+	}
+
+	_, err = GetParams("sha3", false)
+	assert.Equal(t, err, errInvalidKDF)
+
+	// too expensive KDF parameters
+	_, err = EncryptKeyWithParams(key, "test", Params{KDF: KDFScrypt, ScryptN: maxScryptN * 2, ScryptP: 1})
+	assert.Equal(t, err, errInvalidKDFParams)
+}
+
+func Test_PassPhrase_V1(t *testing.T) {
+	addr, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		panic(err)
+	}
+
+	result := encryptKeyV1(&Key{*addr, privateKey}, "test")
+
+	decryptKey, err := DecryptKey(result, "test")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decryptKey.Address, *addr)
+	assert.Equal(t, crypto.FromECDSA(decryptKey.PrivateKey), crypto.FromECDSA(privateKey))
+
+	params, version, err := getParams(result)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, version, version1)
+	assert.Equal(t, params, StandardParams)
+
+	_, err = DecryptKey(result, "badpass")
+	assert.Equal(t, err, ErrDecrypt)
+}
+
+// encryptKeyV1 encrypts the key in the legacy version 1 format.
+func encryptKeyV1(key *Key, auth string) []byte {
+	salt := getRandBuff(32)
+	scryptKey, err := scrypt.Key([]byte(auth), salt, ScryptN, scryptR, ScryptP, scryptDKLen)
+	if err != nil {
+		panic(err)
+	}
+
+	iv := getRandBuff(aes.BlockSize)
+	cipherText, err := aesCTRXOR(scryptKey[:16], math.PaddedBigBytes(key.PrivateKey.D, 32), iv)
+	if err != nil {
+		panic(err)
+	}
+
+	info, _ := json.Marshal(cryptoInfoV1{
+		CipherText: hex.EncodeToString(cipherText),
+		CipherIV:   hex.EncodeToString(iv),
+		Salt:       hex.EncodeToString(salt),
+		MAC:        crypto.HashBytes(scryptKey[16:32], cipherText).ToHex(),
+	})
+
+	result, _ := json.Marshal(encryptedKey{version1, key.Address.ToHex(), info})
+	return result
+}
+
+func Test_PassPhrase_ShortAddress(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+
+	key := &Key{*crypto.GetShortAddress(privateKey), privateKey}
+	result, err := EncryptKeyWithParams(key, "test", LightParams)
+	assert.Equal(t, err, nil)
+
+	decryptKey, err := DecryptKey(result, "test")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decryptKey.Address, key.Address)
+	assert.Equal(t, decryptKey.Address.IsShort(), true)
+}
@@ -2,13 +2,17 @@ package keystore
 
 import (
 	"crypto/ecdsa"
+	"encoding/json"
 
 	"github.com/seeleteam/go-seele/common"
 )
 
 const (
 	// Version keystore version
-	Version = 1
+	Version = 2
+
+	// version1 is the legacy keystore version encrypted with the fixed scrypt parameters
+	version1 = 1
 )
 
 // Key private key info for wallet
@@ -19,15 +23,43 @@ type Key struct {
 	PrivateKey *ecdsa.PrivateKey
 }
 
+// encryptedKey is the key file content, and the crypto info depends on the version.
 type encryptedKey struct {
-	Version int        `json:"version"`
-	Address string     `json:"address"`
-	Crypto  cryptoInfo `json:"crypto"`
+	Version int             `json:"version"`
+	Address string          `json:"address"`
+	Crypto  json.RawMessage `json:"crypto"`
 }
 
-type cryptoInfo struct {
+// cryptoInfoV1 is the crypto info of version 1
+type cryptoInfoV1 struct {
 	CipherText string `json:"ciphertext"`
 	CipherIV   string `json:"iv"`
 	Salt       string `json:"salt"`
 	MAC        string `json:"mac"`
 }
+
+// cryptoInfo is the crypto info of the current version, which records the
+// cipher and KDF with their parameters.
+type cryptoInfo struct {
+	Cipher       string       `json:"cipher"`
+	CipherText   string       `json:"ciphertext"`
+	CipherParams cipherParams `json:"cipherparams"`
+	KDF          string       `json:"kdf"`
+	KDFParams    kdfParams    `json:"kdfparams"`
+	MAC          string       `json:"mac"`
+}
+
+type cipherParams struct {
+	IV string `json:"iv"`
+}
+
+// kdfParams is the parameters of scrypt (n, r, p) or pbkdf2 (c, prf).
+type kdfParams struct {
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+	N     int    `json:"n,omitempty"`
+	R     int    `json:"r,omitempty"`
+	P     int    `json:"p,omitempty"`
+	C     int    `json:"c,omitempty"`
+	PRF   string `json:"prf,omitempty"`
+}
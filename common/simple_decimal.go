@@ -6,14 +6,20 @@
 package common
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 )
 
+// seeleDecimals is the number of decimal places of base coin number
+const seeleDecimals = 8
+
 var (
 	//SeeleToCoin base coin number
 	SeeleToCoin = big.NewInt(100000000)
+
+	errInvalidDecimal = errors.New("invalid decimal")
 )
 
 //BigToDecimal simply changes big int to decimal which will miss additional 0 in the last
@@ -34,3 +40,27 @@ func BigToDecimal(amount *big.Int) string {
 	}
 	return numstr
 }
+
+// DecimalToBig changes decimal to big int, which is the reverse of BigToDecimal
+func DecimalToBig(decimal string) (*big.Int, error) {
+	parts := strings.Split(decimal, ".")
+	if len(parts) > 2 || len(parts[0]) == 0 {
+		return nil, errInvalidDecimal
+	}
+
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = parts[1]
+		if len(fraction) == 0 || len(fraction) > seeleDecimals || strings.ContainsAny(fraction, "+-") {
+			return nil, errInvalidDecimal
+		}
+	}
+
+	fraction += strings.Repeat("0", seeleDecimals-len(fraction))
+	amount, ok := big.NewInt(0).SetString(parts[0]+fraction, 10)
+	if !ok {
+		return nil, errInvalidDecimal
+	}
+
+	return amount, nil
+}
@@ -33,3 +33,26 @@ func Test_BigToDecimal(t *testing.T) {
 	number = big.NewInt(800000600)
 	assert.Equal(t, BigToDecimal(number), "8.000006")
 }
+
+func Test_DecimalToBig(t *testing.T) {
+	amount, err := DecimalToBig("1")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, amount, big.NewInt(100000000))
+
+	amount, err = DecimalToBig("0.00000123")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, amount, big.NewInt(123))
+
+	amount, err = DecimalToBig("1000.12345678")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, amount, big.NewInt(100012345678))
+
+	amount, err = DecimalToBig("8.000006")
+	assert.Equal(t, err, nil)
+	assert.Equal(t, BigToDecimal(amount), "8.000006")
+
+	for _, decimal := range []string{"", ".1", "1.", "1.123456789", "1.-1", "1.2.3", "abc"} {
+		_, err = DecimalToBig(decimal)
+		assert.Equal(t, err, errInvalidDecimal)
+	}
+}
@@ -8,14 +8,14 @@ package common
 import (
 	"bytes"
 	"fmt"
+	"os"
 
 	"github.com/howeyc/gopass"
 )
 
 // GetPassword ask user for password interactively
 func GetPassword() (string, error) {
-	fmt.Printf("Please input your key file password: ")
-	pass, err := gopass.GetPasswd()
+	pass, err := readPassword("Please input your key file password: ")
 	if err != nil {
 		return "", err
 	}
@@ -25,14 +25,12 @@ func GetPassword() (string, error) {
 
 // SetPassword ask user input password twice and get the password interactively
 func SetPassword() (string, error) {
-	fmt.Printf("Password: ")
-	pass, err := gopass.GetPasswd()
+	pass, err := readPassword("Password: ")
 	if err != nil {
 		return "", err
 	}
 
-	fmt.Printf("Repeat password:")
-	passRepeat, err := gopass.GetPasswd()
+	passRepeat, err := readPassword("Repeat password:")
 	if err != nil {
 		return "", err
 	}
@@ -43,3 +41,34 @@ func SetPassword() (string, error) {
 
 	return string(pass), nil
 }
+
+// GetMnemonic ask user for the mnemonic and its optional password interactively
+func GetMnemonic() (string, string, error) {
+	mnemonic, err := readPassword("Please input your mnemonic: ")
+	if err != nil {
+		return "", "", err
+	}
+
+	pass, err := readPassword("Please input your mnemonic password (empty if none): ")
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(bytes.TrimSpace(mnemonic)), string(pass), nil
+}
+
+// GetPrivateKey ask user for the private key in HEX interactively without echo
+func GetPrivateKey() (string, error) {
+	key, err := readPassword("Please input your private key: ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSpace(key)), nil
+}
+
+// readPassword reads the password from stdin with the prompt written to stderr,
+// so that the output of commands in stdout is not mixed with the prompt.
+func readPassword(prompt string) ([]byte, error) {
+	return gopass.GetPasswdPrompt(prompt, false, os.Stdin, os.Stderr)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/core/vm"
 	"github.com/seeleteam/go-seele/database"
+	"github.com/seeleteam/go-seele/event"
 	"github.com/seeleteam/go-seele/miner/pow"
 )
 
@@ -54,6 +55,10 @@ var (
 	// does not match the receipts root hash in block header.
 	ErrBlockReceiptHashMismatch = errors.New("block receipts hash mismatch")
 
+	// ErrBlockLogsBloomMismatch is returned when the calculated logs bloom filter of block
+	// does not match the logs bloom filter in block header.
+	ErrBlockLogsBloomMismatch = errors.New("block logs bloom mismatch")
+
 	// ErrBlockEmptyTxs is returned when writing a block with empty transactions.
 	ErrBlockEmptyTxs = errors.New("empty transactions in block")
 
@@ -101,6 +106,7 @@ type Blockchain struct {
 	engine         consensusEngine
 	headerChain    *HeaderChain
 	genesisBlock   *types.Block
+	chainConfig    *ChainConfig
 	lock           sync.RWMutex // lock for update blockchain info. for example write block
 
 	blockLeaves *BlockLeaves
@@ -111,7 +117,6 @@ func NewBlockchain(bcStore store.BlockchainStore, accountStateDB database.Databa
 	bc := &Blockchain{
 		bcStore:        bcStore,
 		accountStateDB: accountStateDB,
-		engine:         &pow.Engine{},
 	}
 
 	var err error
@@ -131,6 +136,13 @@ func NewBlockchain(bcStore store.BlockchainStore, accountStateDB database.Databa
 		return nil, err
 	}
 
+	// Get the chain config from genesis block
+	if bc.chainConfig, err = getChainConfig(bc.genesisBlock); err != nil {
+		return nil, err
+	}
+
+	bc.engine = &pow.Engine{Rewards: bc.chainConfig.Rewards}
+
 	// Get the HEAD block from store
 	currentHeaderHash, err := bcStore.GetHeadBlockHash()
 	if err != nil {
@@ -160,6 +172,11 @@ func NewBlockchain(bcStore store.BlockchainStore, accountStateDB database.Databa
 	return bc, nil
 }
 
+// ChainConfig returns the chain config that stored in the genesis block.
+func (bc *Blockchain) ChainConfig() *ChainConfig {
+	return bc.chainConfig
+}
+
 // CurrentBlock returns the HEAD block of the blockchain.
 func (bc *Blockchain) CurrentBlock() (*types.Block, *state.Statedb) {
 	bc.lock.RLock()
@@ -216,6 +233,11 @@ func (bc *Blockchain) WriteBlock(block *types.Block) error {
 		return ErrBlockReceiptHashMismatch
 	}
 
+	// Validate logs bloom filter.
+	if logsBloom := types.CreateBloom(receipts); logsBloom != block.Header.LogsBloom {
+		return ErrBlockLogsBloomMismatch
+	}
+
 	// Validate state root hash.
 	batch := bc.accountStateDB.NewBatch()
 	committed := false
@@ -278,6 +300,10 @@ func (bc *Blockchain) WriteBlock(block *types.Block) error {
 
 	committed = true
 
+	if isHead {
+		event.BlockInsertedEventManager.Fire(currentBlock)
+	}
+
 	return nil
 }
 
@@ -387,7 +413,7 @@ func (bc *Blockchain) updateStateDB(statedb *state.Statedb, minerRewardTx *types
 	
 	// process other txs
 	for i, tx := range txs {
-		if err := tx.Validate(statedb); err != nil {
+		if err := tx.Validate(statedb, bc.chainConfig.TxRules(blockHeader.Height)); err != nil {
 			return nil, err
 		}
 
@@ -404,8 +430,8 @@ func (bc *Blockchain) updateStateDB(statedb *state.Statedb, minerRewardTx *types
 
 // ApplyTransaction applies a transaction, changes corresponding statedb and generates its receipt
 func (bc *Blockchain) ApplyTransaction(tx *types.Transaction, txIndex int, coinbase common.Address, statedb *state.Statedb, blockHeader *types.BlockHeader) (*types.Receipt, error) {
-	context := newEVMContext(tx, blockHeader, coinbase, bc.bcStore)
-	receipt, err := processContract(context, tx, txIndex, statedb, &vm.Config{})
+	context := newEVMContext(tx, blockHeader, coinbase, bc.chainConfig, bc.bcStore)
+	receipt, err := processContract(context, tx, txIndex, statedb, bc.chainConfig, &vm.Config{})
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +58,7 @@ func newTestGenesis() *Genesis {
 		accounts[account.addr] = account.data.Amount
 	}
 
-	return GetGenesis(GenesisInfo{accounts, 1, 0})
+	return GetGenesis(GenesisInfo{accounts, 1, 0, nil})
 }
 
 func newTestBlockchain(db database.Database) *Blockchain {
@@ -180,6 +180,19 @@ func Test_Blockchain_WriteBlock_InvalidHeight(t *testing.T) {
 	assert.Equal(t, bc.WriteBlock(newBlock), ErrBlockInvalidHeight)
 }
 
+func Test_Blockchain_WriteBlock_LogsBloomChanged(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	bc := newTestBlockchain(db)
+
+	newBlock := newTestBlock(bc, bc.genesisBlock.HeaderHash, 1, 3, 0)
+	newBlock.Header.LogsBloom.Add([]byte("data"))
+	newBlock.HeaderHash = newBlock.Header.Hash()
+
+	assert.Equal(t, bc.WriteBlock(newBlock), ErrBlockLogsBloomMismatch)
+}
+
 func Test_Blockchain_WriteBlock_ValidBlock(t *testing.T) {
 	db, dispose := newTestDatabase()
 	defer dispose()
@@ -336,7 +349,7 @@ func Test_Blockchain_Shard(t *testing.T) {
 	defer dispose()
 
 	bcStore := store.NewBlockchainDatabase(db)
-	genesis := GetGenesis(GenesisInfo{nil, 1, 8})
+	genesis := GetGenesis(GenesisInfo{nil, 1, 8, nil})
 	if err := genesis.InitializeAndValidate(bcStore, db); err != nil {
 		panic(err)
 	}
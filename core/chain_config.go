@@ -0,0 +1,140 @@
+/**
+* @file
+* @copyright defined in go-seele/LICENSE
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/miner/pow"
+)
+
+var (
+	// ErrChainIDInvalid is returned when the chain ID in chain config is nil or not positive.
+	ErrChainIDInvalid = errors.New("invalid chain ID")
+
+	// ErrBlockGasLimitZero is returned when the block gas limit in chain config is zero.
+	ErrBlockGasLimitZero = errors.New("block gas limit is zero")
+
+	// ErrRewardScheduleNil is returned when the reward schedule in chain config is nil.
+	ErrRewardScheduleNil = errors.New("reward schedule is nil")
+)
+
+// ChainConfig is the seele chain configuration, including the fork heights of EVM,
+// block gas limit and miner reward schedule. It's stored in the genesis block, so that
+// protocol upgrades could be scheduled on private networks without forking the code.
+// For a fork height, nil indicates no fork and 0 indicates already activated.
+type ChainConfig struct {
+	ChainID              *big.Int            `json:"chainId"`
+	HomesteadHeight      *big.Int            `json:"homesteadHeight"`
+	EIP150Height         *big.Int            `json:"eip150Height"`
+	EIP155Height         *big.Int            `json:"eip155Height"`
+	EIP158Height         *big.Int            `json:"eip158Height"`
+	ByzantiumHeight      *big.Int            `json:"byzantiumHeight"`
+	ConstantinopleHeight *big.Int            `json:"constantinopleHeight"`
+	BlockGasLimit        uint64              `json:"blockGasLimit"`
+	Rewards              *pow.RewardSchedule `json:"rewards"`
+
+	// ShortAddress indicates whether the short address derived from the hash of public key
+	// is allowed, of which the transactions are signed with recoverable signatures. It could
+	// only be enabled for new networks since the legacy nodes could not verify them.
+	ShortAddress bool `json:"shortAddress,omitempty"`
+
+	// MultiSigHeight is the height from which the transaction to MultiSigCreationAddress creates
+	// a multisig account, and it's a normal transfer before. The multisig is disabled if nil.
+	MultiSigHeight *big.Int `json:"multiSigHeight,omitempty"`
+}
+
+// DefaultChainConfig returns the default chain config, in which all the forks
+// are activated at genesis except Constantinople. Note, there is no gas limit
+// by default since the gas fee is not supported yet.
+func DefaultChainConfig() *ChainConfig {
+	return &ChainConfig{
+		ChainID:              big.NewInt(1),
+		HomesteadHeight:      big.NewInt(0),
+		EIP150Height:         big.NewInt(0),
+		EIP155Height:         big.NewInt(0),
+		EIP158Height:         big.NewInt(0),
+		ByzantiumHeight:      big.NewInt(0),
+		ConstantinopleHeight: nil,
+		BlockGasLimit:        math.MaxUint64,
+		Rewards:              pow.DefaultRewardSchedule(),
+	}
+}
+
+// Validate returns error if the chain config is invalid, e.g. forks are not scheduled in order.
+func (config *ChainConfig) Validate() error {
+	if config.ChainID == nil || config.ChainID.Sign() <= 0 {
+		return ErrChainIDInvalid
+	}
+
+	forks := []struct {
+		name   string
+		height *big.Int
+	}{
+		{"homestead", config.HomesteadHeight},
+		{"eip150", config.EIP150Height},
+		{"eip155", config.EIP155Height},
+		{"eip158", config.EIP158Height},
+		{"byzantium", config.ByzantiumHeight},
+		{"constantinople", config.ConstantinopleHeight},
+	}
+
+	for i := 1; i < len(forks); i++ {
+		prev, cur := forks[i-1], forks[i]
+		if cur.height == nil {
+			continue
+		}
+
+		if prev.height == nil || prev.height.Cmp(cur.height) > 0 {
+			return fmt.Errorf("invalid fork order, %v height %v, %v height %v", prev.name, prev.height, cur.name, cur.height)
+		}
+	}
+
+	if config.BlockGasLimit == 0 {
+		return ErrBlockGasLimitZero
+	}
+
+	if config.Rewards == nil {
+		return ErrRewardScheduleNil
+	}
+
+	return config.Rewards.Validate()
+}
+
+// IsMultiSig returns whether the multisig account is enabled at the specified block height.
+func (config *ChainConfig) IsMultiSig(height uint64) bool {
+	return config.MultiSigHeight != nil && config.MultiSigHeight.Cmp(new(big.Int).SetUint64(height)) <= 0
+}
+
+// TxRules returns the rules to validate transactions of the block at the specified height.
+func (config *ChainConfig) TxRules(height uint64) types.TxRules {
+	return types.TxRules{
+		ShortAddress: config.ShortAddress,
+		MultiSig:     config.IsMultiSig(height),
+	}
+}
+
+// EVMConfig returns the EVM chain config that converted from the seele chain config.
+// The DAO fork is always activated at genesis as before the chain config was added.
+func (config *ChainConfig) EVMConfig() *params.ChainConfig {
+	return &params.ChainConfig{
+		ChainId:             config.ChainID,
+		HomesteadBlock:      config.HomesteadHeight,
+		DAOForkBlock:        big.NewInt(0),
+		DAOForkSupport:      true,
+		EIP150Block:         config.EIP150Height,
+		EIP155Block:         config.EIP155Height,
+		EIP158Block:         config.EIP158Height,
+		ByzantiumBlock:      config.ByzantiumHeight,
+		ConstantinopleBlock: config.ConstantinopleHeight,
+		Ethash:              new(params.EthashConfig),
+	}
+}
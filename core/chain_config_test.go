@@ -0,0 +1,83 @@
+/**
+* @file
+* @copyright defined in go-seele/LICENSE
+ */
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_ChainConfig_Validate(t *testing.T) {
+	config := DefaultChainConfig()
+	assert.Equal(t, config.Validate(), error(nil))
+
+	// schedule the Constantinople fork
+	config.ConstantinopleHeight = big.NewInt(100)
+	assert.Equal(t, config.Validate(), error(nil))
+
+	// invalid chain ID
+	config = DefaultChainConfig()
+	config.ChainID = big.NewInt(0)
+	assert.Equal(t, config.Validate(), ErrChainIDInvalid)
+
+	// zero gas limit
+	config = DefaultChainConfig()
+	config.BlockGasLimit = 0
+	assert.Equal(t, config.Validate(), ErrBlockGasLimitZero)
+
+	// nil reward schedule
+	config = DefaultChainConfig()
+	config.Rewards = nil
+	assert.Equal(t, config.Validate(), ErrRewardScheduleNil)
+}
+
+func Test_ChainConfig_Validate_ForkOrder(t *testing.T) {
+	// later fork activated before earlier fork
+	config := DefaultChainConfig()
+	config.HomesteadHeight = big.NewInt(10)
+	if err := config.Validate(); err == nil {
+		t.Fatal("expected error for invalid fork order")
+	}
+
+	// later fork activated while earlier fork disabled
+	config = DefaultChainConfig()
+	config.EIP158Height = nil
+	if err := config.Validate(); err == nil {
+		t.Fatal("expected error for invalid fork order")
+	}
+}
+
+func Test_ChainConfig_EVMConfig(t *testing.T) {
+	config := DefaultChainConfig()
+	config.ChainID = big.NewInt(38)
+	config.ConstantinopleHeight = big.NewInt(100)
+
+	evmConfig := config.EVMConfig()
+	assert.Equal(t, evmConfig.ChainId, big.NewInt(38))
+	assert.Equal(t, evmConfig.IsByzantium(big.NewInt(0)), true)
+	assert.Equal(t, evmConfig.IsConstantinople(big.NewInt(99)), false)
+	assert.Equal(t, evmConfig.IsConstantinople(big.NewInt(100)), true)
+
+	// DAO fork is activated at genesis
+	assert.Equal(t, evmConfig.DAOForkBlock, big.NewInt(0))
+	assert.Equal(t, evmConfig.DAOForkSupport, true)
+	assert.Equal(t, evmConfig.IsDAOFork(big.NewInt(0)), true)
+}
+
+func Test_ChainConfig_TxRules(t *testing.T) {
+	config := DefaultChainConfig()
+	assert.Equal(t, config.TxRules(0).ShortAddress, false)
+	assert.Equal(t, config.TxRules(100).MultiSig, false)
+
+	config.ShortAddress = true
+	config.MultiSigHeight = big.NewInt(10)
+	assert.Equal(t, config.TxRules(0).ShortAddress, true)
+	assert.Equal(t, config.TxRules(9).MultiSig, false)
+	assert.Equal(t, config.TxRules(10).MultiSig, true)
+	assert.Equal(t, config.TxRules(11).MultiSig, true)
+}
@@ -6,19 +6,21 @@
 package core
 
 import (
-	"math"
+	"errors"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/params"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/core/state"
 	"github.com/seeleteam/go-seele/core/store"
 	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/core/vm"
+	"github.com/seeleteam/go-seele/crypto"
 )
 
+var errMultiSigAccountExists = errors.New("multisig account already exists")
+
 // newEVMContext creates a new context for use in the EVM.
-func newEVMContext(tx *types.Transaction, header *types.BlockHeader, minerAddress common.Address, bcStore store.BlockchainStore) *vm.Context {
+func newEVMContext(tx *types.Transaction, header *types.BlockHeader, minerAddress common.Address, chainConfig *ChainConfig, bcStore store.BlockchainStore) *vm.Context {
 	canTransferFunc := func(db vm.StateDB, addr common.Address, amount *big.Int) bool {
 		return db.GetBalance(addr).Cmp(amount) >= 0
 	}
@@ -56,26 +58,31 @@ func newEVMContext(tx *types.Transaction, header *types.BlockHeader, minerAddres
 		BlockNumber: new(big.Int).SetUint64(header.Height),
 		Time:        new(big.Int).Set(header.CreateTimestamp),
 		Difficulty:  new(big.Int).Set(header.Difficulty),
-		// GasLimit:    header.GasLimit,
+		GasLimit:    chainConfig.BlockGasLimit,
 		// GasPrice:    new(big.Int).Set(tx.GasPrice()),
 	}
 }
 
 // processContract process the specified contract tx and return the receipt.
-func processContract(context *vm.Context, tx *types.Transaction, txIndex int, statedb *state.Statedb, vmConfig *vm.Config) (*types.Receipt, error) {
+func processContract(context *vm.Context, tx *types.Transaction, txIndex int, statedb *state.Statedb, chainConfig *ChainConfig, vmConfig *vm.Config) (*types.Receipt, error) {
 	statedb.Prepare(txIndex)
-	evm := vm.NewEVM(*context, statedb, getDefaultChainConfig(), *vmConfig)
+	evm := vm.NewEVM(*context, statedb, chainConfig.EVMConfig(), *vmConfig)
 
 	var err error
 	caller := vm.AccountRef(tx.Data.From)
 	receipt := &types.Receipt{TxHash: tx.Hash}
 
-	// Currently, use math.MaxUint64 gas to bypass ErrInsufficientBalance error.
-	if tx.Data.To == nil {
-		receipt.Result, receipt.ContractAddress, _, err = evm.Create(caller, tx.Data.Payload, math.MaxUint64, tx.Data.Amount)
+	// Currently, use the block gas limit to bypass ErrInsufficientBalance error,
+	// which is math.MaxUint64 by default since the gas fee is not supported yet.
+	// Note, the sender nonce is increased in EVM when creating a contract,
+	// and the contract address is derived from the sender address and nonce.
+	if chainConfig.IsMultiSig(context.BlockNumber.Uint64()) && tx.IsMultiSigCreation() {
+		receipt.ContractAddress, err = processMultiSigCreation(tx, statedb)
+	} else if tx.Data.To == nil {
+		receipt.Result, receipt.ContractAddress, _, err = evm.Create(caller, tx.Data.Payload, context.GasLimit, tx.Data.Amount)
 	} else {
 		statedb.SetNonce(tx.Data.From, statedb.GetNonce(tx.Data.From)+1)
-		receipt.Result, _, err = evm.Call(caller, *tx.Data.To, tx.Data.Payload, math.MaxUint64, tx.Data.Amount)
+		receipt.Result, _, err = evm.Call(caller, *tx.Data.To, tx.Data.Payload, context.GasLimit, tx.Data.Amount)
 	}
 
 	if err != nil {
@@ -91,20 +98,33 @@ func processContract(context *vm.Context, tx *types.Transaction, txIndex int, st
 		receipt.Logs = make([]*types.Log, 0)
 	}
 
+	receipt.Bloom = types.LogsBloom(receipt.Logs)
+
 	return receipt, nil
 }
 
-func getDefaultChainConfig() *params.ChainConfig {
-	return &params.ChainConfig{
-		ChainId:             big.NewInt(1),
-		HomesteadBlock:      big.NewInt(0),
-		DAOForkBlock:        big.NewInt(0),
-		DAOForkSupport:      true,
-		EIP150Block:         big.NewInt(0),
-		EIP155Block:         big.NewInt(0),
-		EIP158Block:         big.NewInt(0),
-		ByzantiumBlock:      big.NewInt(0),
-		ConstantinopleBlock: nil,
-		Ethash:              new(params.EthashConfig),
+// processMultiSigCreation creates the multisig account of the specified tx without EVM, and
+// returns the account address, which is derived from the sender address and nonce.
+func processMultiSigCreation(tx *types.Transaction, statedb *state.Statedb) (common.Address, error) {
+	multiSig, err := tx.GetMultiSigAccount()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if statedb.GetBalance(tx.Data.From).Cmp(tx.Data.Amount) < 0 {
+		return common.Address{}, types.ErrBalanceNotEnough
 	}
+
+	nonce := statedb.GetNonce(tx.Data.From)
+	addr := crypto.CreateAddress(tx.Data.From, nonce)
+	if statedb.GetMultiSig(addr) != nil || statedb.GetCodeSize(addr) > 0 {
+		return common.Address{}, errMultiSigAccountExists
+	}
+
+	statedb.SetNonce(tx.Data.From, nonce+1)
+	statedb.SetMultiSig(addr, multiSig)
+	statedb.SubBalance(tx.Data.From, tx.Data.Amount)
+	statedb.AddBalance(addr, tx.Data.Amount)
+
+	return addr, nil
 }
@@ -0,0 +1,180 @@
+/**
+* @file
+* @copyright defined in go-seele/LICENSE
+ */
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/state"
+	"github.com/seeleteam/go-seele/core/store"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/core/vm"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+func Test_ProcessContract_CreateContract(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	statedb, err := state.NewStatedb(common.EmptyHash, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := *crypto.MustGenerateRandomAddress()
+	statedb.GetOrNewStateObject(from).SetAmount(big.NewInt(100))
+
+	header := &types.BlockHeader{
+		Height:          1,
+		Difficulty:      big.NewInt(1),
+		CreateTimestamp: big.NewInt(1),
+	}
+	bcStore := store.NewBlockchainDatabase(db)
+	chainConfig := DefaultChainConfig()
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		// The code only stops the execution.
+		tx, err := types.NewContractTransaction(from, big.NewInt(0), big.NewInt(0), nonce, []byte{0x00})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		context := newEVMContext(tx, header, common.Address{}, chainConfig, bcStore)
+		receipt, err := processContract(context, tx, 1, statedb, chainConfig, &vm.Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		assert.Equal(t, receipt.ContractAddress, crypto.CreateAddress(from, nonce))
+		assert.Equal(t, statedb.GetNonce(from), nonce+1)
+	}
+
+	// The nonce is not increased if failed to create contract due to insufficient balance.
+	tx, err := types.NewContractTransaction(from, big.NewInt(101), big.NewInt(0), 3, []byte{0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	context := newEVMContext(tx, header, common.Address{}, chainConfig, bcStore)
+	_, err = processContract(context, tx, 1, statedb, chainConfig, &vm.Config{})
+	assert.Equal(t, err, vm.ErrInsufficientBalance)
+	assert.Equal(t, statedb.GetNonce(from), uint64(3))
+}
+
+func Test_ProcessContract_CreateMultiSig(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	statedb, err := state.NewStatedb(common.EmptyHash, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := *crypto.MustGenerateRandomAddress()
+	statedb.GetOrNewStateObject(from).SetAmount(big.NewInt(100))
+	statedb.SetNonce(from, 5)
+
+	header := &types.BlockHeader{
+		Height:          1,
+		Difficulty:      big.NewInt(1),
+		CreateTimestamp: big.NewInt(1),
+	}
+	bcStore := store.NewBlockchainDatabase(db)
+	chainConfig := DefaultChainConfig()
+	chainConfig.MultiSigHeight = big.NewInt(2)
+
+	owners := []common.Address{*crypto.MustGenerateRandomAddress(), *crypto.MustGenerateRandomAddress()}
+	tx, err := types.NewMultiSigTransaction(from, owners, 2, big.NewInt(30), big.NewInt(0), 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a normal transfer before the multisig height
+	context := newEVMContext(tx, header, common.Address{}, chainConfig, bcStore)
+	receipt, err := processContract(context, tx, 1, statedb, chainConfig, &vm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr := crypto.CreateAddress(from, 5)
+	assert.Equal(t, receipt.ContractAddress, common.Address{})
+	assert.Equal(t, statedb.GetMultiSig(addr) == nil, true)
+	assert.Equal(t, statedb.GetBalance(types.MultiSigCreationAddress), big.NewInt(30))
+	assert.Equal(t, statedb.GetBalance(from), big.NewInt(70))
+	assert.Equal(t, statedb.GetNonce(from), uint64(6))
+
+	// create the multisig account since the multisig height
+	header.Height = 2
+	tx, err = types.NewMultiSigTransaction(from, owners, 2, big.NewInt(30), big.NewInt(0), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	context = newEVMContext(tx, header, common.Address{}, chainConfig, bcStore)
+	if receipt, err = processContract(context, tx, 1, statedb, chainConfig, &vm.Config{}); err != nil {
+		t.Fatal(err)
+	}
+
+	addr = crypto.CreateAddress(from, 6)
+	assert.Equal(t, receipt.ContractAddress, addr)
+	assert.Equal(t, statedb.GetMultiSig(addr), &types.MultiSigAccount{Threshold: 2, Owners: owners})
+	assert.Equal(t, statedb.GetBalance(addr), big.NewInt(30))
+	assert.Equal(t, statedb.GetBalance(from), big.NewInt(40))
+	assert.Equal(t, statedb.GetNonce(from), uint64(7))
+}
+
+func Test_ProcessContract_GasLimit(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	statedb, err := state.NewStatedb(common.EmptyHash, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from := *crypto.MustGenerateRandomAddress()
+	statedb.GetOrNewStateObject(from).SetAmount(big.NewInt(100))
+
+	// The code returns the GASLIMIT: GASLIMIT PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	contract := *crypto.MustGenerateRandomAddress()
+	statedb.CreateAccount(contract)
+	statedb.SetCode(contract, []byte{0x45, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3})
+
+	batch := db.NewBatch()
+	if _, err = statedb.Commit(batch); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = batch.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	header := &types.BlockHeader{
+		Height:          1,
+		Difficulty:      big.NewInt(1),
+		CreateTimestamp: big.NewInt(1),
+	}
+	bcStore := store.NewBlockchainDatabase(db)
+	chainConfig := DefaultChainConfig()
+	chainConfig.BlockGasLimit = 8000000
+
+	tx, err := types.NewMessageTransaction(from, contract, big.NewInt(0), big.NewInt(0), 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// GASLIMIT returns the block gas limit of chain config
+	context := newEVMContext(tx, header, common.Address{}, chainConfig, bcStore)
+	receipt, err := processContract(context, tx, 1, statedb, chainConfig, &vm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, new(big.Int).SetBytes(receipt.Result).Uint64(), chainConfig.BlockGasLimit)
+}
@@ -6,6 +6,8 @@
 package core
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -21,6 +23,9 @@ var (
 	// ErrGenesisHashMismatch is returned when the genesis block hash between the store and memory mismatch.
 	ErrGenesisHashMismatch = errors.New("genesis block hash mismatch")
 
+	// ErrGenesisChainConfigMismatch is returned when the chain config between the store and memory mismatch.
+	ErrGenesisChainConfigMismatch = errors.New("genesis chain config mismatch")
+
 	// ErrGenesisNotFound is returned when genesis block not found in the store.
 	ErrGenesisNotFound = errors.New("genesis block not found")
 )
@@ -44,11 +49,23 @@ type GenesisInfo struct {
 
 	// ShardNumber is the shard number of genesis block.
 	ShardNumber uint `json:"shard"`
+
+	// ChainConfig is the chain config, e.g. fork heights and reward schedule.
+	// The default chain config is used if nil, and the genesis block is the
+	// same as the one without chain config, so that existing nodes could start.
+	ChainConfig *ChainConfig `json:"chainConfig"`
 }
 
 // genesisExtraData represents the extra data that saved in the genesis block in the blockchain.
 type genesisExtraData struct {
 	ShardNumber uint
+	ChainConfig []byte // JSON encoded chain config, since RLP could not distinguish nil and zero big int.
+}
+
+// legacyGenesisExtraData represents the extra data of genesis block without chain config,
+// which is used for the default chain config to keep the genesis block hash unchanged.
+type legacyGenesisExtraData struct {
+	ShardNumber uint
 }
 
 // GetGenesis gets the genesis block according to accounts' balance
@@ -57,6 +74,21 @@ func GetGenesis(info GenesisInfo) *Genesis {
 		info.Difficult = 1
 	}
 
+	// Use the legacy extra data if chain config not specified, so that the genesis
+	// block of existing nodes is still valid.
+	var extraData []byte
+	if info.ChainConfig == nil {
+		info.ChainConfig = DefaultChainConfig()
+		extraData = common.SerializePanic(legacyGenesisExtraData{info.ShardNumber})
+	} else {
+		chainConfig, err := json.Marshal(info.ChainConfig)
+		if err != nil {
+			panic(err)
+		}
+
+		extraData = common.SerializePanic(genesisExtraData{info.ShardNumber, chainConfig})
+	}
+
 	statedb, err := getStateDB(info)
 	if err != nil {
 		panic(err)
@@ -67,8 +99,6 @@ func GetGenesis(info GenesisInfo) *Genesis {
 		panic(err)
 	}
 
-	extraData := genesisExtraData{info.ShardNumber}
-
 	return &Genesis{
 		header: &types.BlockHeader{
 			PreviousBlockHash: common.EmptyHash,
@@ -79,7 +109,7 @@ func GetGenesis(info GenesisInfo) *Genesis {
 			Height:            genesisBlockHeight,
 			CreateTimestamp:   big.NewInt(0),
 			Nonce:             1,
-			ExtraData:         common.SerializePanic(extraData),
+			ExtraData:         extraData,
 		},
 		info: info,
 	}
@@ -93,6 +123,10 @@ func (genesis *Genesis) GetShardNumber() uint {
 // InitializeAndValidate writes the genesis block in the blockchain store if unavailable.
 // Otherwise, check if the existing genesis block is valid in the blockchain store.
 func (genesis *Genesis) InitializeAndValidate(bcStore store.BlockchainStore, accountStateDB database.Database) error {
+	if err := genesis.info.ChainConfig.Validate(); err != nil {
+		return errors.New(fmt.Sprintf("invalid chain config. %s", err))
+	}
+
 	storedGenesisHash, err := bcStore.GetBlockHash(genesisBlockHeight)
 
 	// FIXME use seele-defined common error instead of concrete levelDB error.
@@ -118,6 +152,16 @@ func (genesis *Genesis) InitializeAndValidate(bcStore store.BlockchainStore, acc
 		return errors.New("specific shard is not matched with shard number in genesis info")
 	}
 
+	storedConfig, err := data.chainConfig()
+	if err != nil {
+		return errors.New(fmt.Sprintf("get genesis chain config failed. %s", err))
+	}
+
+	storedConfigJSON, _ := json.Marshal(storedConfig)
+	if chainConfig, _ := json.Marshal(genesis.info.ChainConfig); !bytes.Equal(storedConfigJSON, chainConfig) {
+		return ErrGenesisChainConfigMismatch
+	}
+
 	headerHash := genesis.header.Hash()
 	if !headerHash.Equal(storedGenesisHash) {
 		return ErrGenesisHashMismatch
@@ -166,9 +210,38 @@ func getGenesisExtraData(genesisBlock *types.Block) (*genesisExtraData, error) {
 	}
 
 	data := genesisExtraData{}
-	if err := common.Deserialize(genesisBlock.Header.ExtraData, &data); err != nil {
+	if err := common.Deserialize(genesisBlock.Header.ExtraData, &data); err == nil {
+		return &data, nil
+	}
+
+	legacyData := legacyGenesisExtraData{}
+	if err := common.Deserialize(genesisBlock.Header.ExtraData, &legacyData); err != nil {
+		return nil, err
+	}
+
+	return &genesisExtraData{ShardNumber: legacyData.ShardNumber}, nil
+}
+
+// chainConfig returns the chain config in the extra data, or the default chain config if not specified.
+func (data *genesisExtraData) chainConfig() (*ChainConfig, error) {
+	if len(data.ChainConfig) == 0 {
+		return DefaultChainConfig(), nil
+	}
+
+	config := &ChainConfig{}
+	if err := json.Unmarshal(data.ChainConfig, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// getChainConfig returns the chain config stored in the specified genesis block.
+func getChainConfig(genesisBlock *types.Block) (*ChainConfig, error) {
+	data, err := getGenesisExtraData(genesisBlock)
+	if err != nil {
 		return nil, err
 	}
 
-	return &data, nil
+	return data.chainConfig()
 }
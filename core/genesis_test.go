@@ -16,6 +16,7 @@ import (
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/core/state"
 	"github.com/seeleteam/go-seele/core/store"
+	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/database"
 	"github.com/seeleteam/go-seele/database/leveldb"
@@ -48,7 +49,7 @@ func Test_Genesis_GetGenesis(t *testing.T) {
 	addr := crypto.MustGenerateRandomAddress()
 	accounts := make(map[common.Address]*big.Int)
 	accounts[*addr] = big.NewInt(10)
-	genesis3 := GetGenesis(GenesisInfo{accounts, 1, 0})
+	genesis3 := GetGenesis(GenesisInfo{accounts, 1, 0, nil})
 	if genesis3.header.StateHash == common.EmptyHash {
 		panic("genesis3 state hash should not equal to empty hash")
 	}
@@ -98,3 +99,76 @@ func Test_Genesis_Init_GenesisMismatch(t *testing.T) {
 	err := genesis.InitializeAndValidate(bcStore, db)
 	assert.Equal(t, err, ErrGenesisHashMismatch)
 }
+
+func Test_Genesis_Init_ChainConfigMismatch(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	bcStore := store.NewBlockchainDatabase(db)
+
+	genesis := GetGenesis(GenesisInfo{})
+	assert.Equal(t, genesis.InitializeAndValidate(bcStore, db), error(nil))
+
+	config := DefaultChainConfig()
+	config.ConstantinopleHeight = big.NewInt(100)
+	genesis = GetGenesis(GenesisInfo{ChainConfig: config})
+	assert.Equal(t, genesis.InitializeAndValidate(bcStore, db), ErrGenesisChainConfigMismatch)
+}
+
+func Test_Genesis_Init_LegacyGenesis(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	bcStore := store.NewBlockchainDatabase(db)
+
+	// genesis block written before chain config added
+	legacyHeader := &types.BlockHeader{
+		PreviousBlockHash: common.EmptyHash,
+		Creator:           common.Address{},
+		StateHash:         common.EmptyHash,
+		TxHash:            types.MerkleRootHash(nil),
+		Difficulty:        big.NewInt(1),
+		Height:            genesisBlockHeight,
+		CreateTimestamp:   big.NewInt(0),
+		Nonce:             1,
+		ExtraData:         common.SerializePanic(struct{ ShardNumber uint }{1}),
+	}
+	legacyHash := legacyHeader.Hash()
+	if err := bcStore.PutBlockHeader(legacyHash, legacyHeader, legacyHeader.Difficulty, true); err != nil {
+		t.Fatal(err)
+	}
+
+	genesis := GetGenesis(GenesisInfo{ShardNumber: 1})
+	assert.Equal(t, genesis.header.Hash(), legacyHash)
+	assert.Equal(t, genesis.InitializeAndValidate(bcStore, db), error(nil))
+
+	storedGenesis, err := bcStore.GetBlock(legacyHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := getChainConfig(storedGenesis)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, config, DefaultChainConfig())
+
+	// chain config specified for the legacy genesis block
+	config = DefaultChainConfig()
+	config.ConstantinopleHeight = big.NewInt(100)
+	genesis = GetGenesis(GenesisInfo{ShardNumber: 1, ChainConfig: config})
+	assert.Equal(t, genesis.InitializeAndValidate(bcStore, db), ErrGenesisChainConfigMismatch)
+}
+
+func Test_Genesis_Init_InvalidChainConfig(t *testing.T) {
+	db, dispose := newTestDatabase()
+	defer dispose()
+
+	bcStore := store.NewBlockchainDatabase(db)
+
+	config := DefaultChainConfig()
+	config.ChainID = nil
+	genesis := GetGenesis(GenesisInfo{ChainConfig: config})
+
+	if err := genesis.InitializeAndValidate(bcStore, db); err == nil {
+		t.Fatal("expected error for invalid chain config")
+	}
+}
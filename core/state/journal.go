@@ -9,6 +9,7 @@ import (
 	"math/big"
 
 	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
 )
 
 type journalEntry interface {
@@ -65,6 +66,10 @@ type (
 	createObjectChange struct {
 		account *common.Address
 	}
+	multiSigChange struct {
+		account *common.Address
+		prev    *types.MultiSigAccount
+	}
 )
 
 func (ch refundChange) revert(s *Statedb) {
@@ -87,6 +92,10 @@ func (ch nonceChange) revert(s *Statedb) {
 	s.getStateObject(*ch.account).account.Nonce = ch.prev
 }
 
+func (ch multiSigChange) revert(s *Statedb) {
+	s.getStateObject(*ch.account).account.MultiSig = ch.prev
+}
+
 func (ch suicideChange) revert(s *Statedb) {
 	obj := s.getStateObject(*ch.account)
 	if obj != nil {
@@ -150,6 +150,22 @@ func (s *Statedb) SetNonce(addr common.Address, nonce uint64) {
 	}
 }
 
+// GetMultiSig gets the multisig info of the specified account, nil if not a multisig account
+func (s *Statedb) GetMultiSig(addr common.Address) *types.MultiSigAccount {
+	object := s.getStateObject(addr)
+	if object != nil {
+		return object.GetMultiSig()
+	}
+	return nil
+}
+
+// SetMultiSig sets the multisig info of the specified account, which is created if not exists
+func (s *Statedb) SetMultiSig(addr common.Address, multiSig *types.MultiSigAccount) {
+	object := s.GetOrNewStateObject(addr)
+	s.curJournal.append(multiSigChange{&addr, object.GetMultiSig()})
+	object.SetMultiSig(multiSig)
+}
+
 // Commit commits memory state objects to db
 func (s *Statedb) Commit(batch database.Batch) (common.Hash, error) {
 	if s.dbErr != nil {
@@ -15,6 +15,7 @@ import (
 
 	"github.com/magiconair/properties/assert"
 	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/database"
 	"github.com/seeleteam/go-seele/database/leveldb"
 )
@@ -183,3 +184,27 @@ func TestStatedb_Cache(t *testing.T) {
 		t.Error("trie root hash should changed")
 	}
 }
+
+func Test_Statedb_MultiSig(t *testing.T) {
+	db, remove := newTestStateDB()
+	defer remove()
+
+	statedb, _ := NewStatedb(common.EmptyHash, db)
+	legacy := getAddr(1)
+	statedb.GetOrNewStateObject(legacy).SetAmount(big.NewInt(10))
+	legacyRoot, _ := statedb.Commit(nil)
+
+	// the account without multisig is encoded as before
+	encoded := common.SerializePanic(statedb.getStateObject(legacy).account)
+	assert.Equal(t, encoded, common.SerializePanic([]interface{}{uint64(0), big.NewInt(10), []byte(nil), []byte(nil)}))
+
+	multiSig := &types.MultiSigAccount{Threshold: 1, Owners: []common.Address{getAddr(3), getAddr(4)}}
+	addr := getAddr(2)
+	statedb.SetMultiSig(addr, multiSig)
+	root, statedb := commitAndNewStateDB(statedb)
+	assert.Equal(t, root == legacyRoot, false)
+
+	assert.Equal(t, statedb.GetMultiSig(addr), multiSig)
+	assert.Equal(t, statedb.GetMultiSig(legacy) == nil, true)
+	assert.Equal(t, statedb.GetBalance(legacy), big.NewInt(10))
+}
@@ -6,9 +6,12 @@
 package state
 
 import (
+	"io"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/database"
 	"github.com/seeleteam/go-seele/trie"
@@ -25,6 +28,42 @@ type Account struct {
 	Amount          *big.Int
 	CodeHash        []byte // contract code hash
 	StorageRootHash []byte // merkle root of the storage trie
+
+	MultiSig *types.MultiSigAccount // owners and threshold of the multisig account, nil for others
+}
+
+// EncodeRLP implements rlp.Encoder. The multisig info is appended only for
+// the multisig account, so that the other accounts are encoded as before.
+func (a Account) EncodeRLP(w io.Writer) error {
+	fields := []interface{}{a.Nonce, a.Amount, a.CodeHash, a.StorageRootHash}
+	if a.MultiSig != nil {
+		fields = append(fields, a.MultiSig)
+	}
+
+	return rlp.Encode(w, fields)
+}
+
+// DecodeRLP implements rlp.Decoder, and the multisig info is optional.
+func (a *Account) DecodeRLP(s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+
+	*a = Account{Amount: new(big.Int)}
+	for _, field := range []interface{}{&a.Nonce, a.Amount, &a.CodeHash, &a.StorageRootHash} {
+		if err := s.Decode(field); err != nil {
+			return err
+		}
+	}
+
+	multiSig := &types.MultiSigAccount{}
+	if err := s.Decode(multiSig); err == nil {
+		a.MultiSig = multiSig
+	} else if err != rlp.EOL {
+		return err
+	}
+
+	return s.ListEnd()
 }
 
 func newAccount() Account {
@@ -39,6 +78,7 @@ func (a Account) clone() Account {
 		Amount:          new(big.Int).Set(a.Amount),
 		CodeHash:        common.CopyBytes(a.CodeHash),
 		StorageRootHash: common.CopyBytes(a.StorageRootHash),
+		MultiSig:        a.MultiSig,
 	}
 }
 
@@ -107,6 +147,17 @@ func (s *StateObject) GetNonce() uint64 {
 	return s.account.Nonce
 }
 
+// GetMultiSig gets the multisig info of the account in the state object
+func (s *StateObject) GetMultiSig() *types.MultiSigAccount {
+	return s.account.MultiSig
+}
+
+// SetMultiSig sets the multisig info of the account in the state object
+func (s *StateObject) SetMultiSig(multiSig *types.MultiSigAccount) {
+	s.account.MultiSig = multiSig
+	s.dirtyAccount = true
+}
+
 // GetAmount gets the balance amount of the account in the state object
 func (s *StateObject) GetAmount() *big.Int {
 	return new(big.Int).Set(s.account.Amount)
@@ -21,11 +21,17 @@ var (
 	errTxPoolFull   = errors.New("transaction pool is full")
 	errTxFeeNil     = errors.New("fee can't be nil")
 	errTxNonceUsed  = errors.New("transaction from this address already used its nonce")
+
+	// errMultiSigDisabled is returned for the multisig creation tx before the multisig height,
+	// which is a normal transfer to the reserved address and the amount could never be spent.
+	errMultiSigDisabled = errors.New("multisig is disabled")
 )
 
 type blockchain interface {
+	CurrentBlock() (*types.Block, *state.Statedb)
 	CurrentState() *state.Statedb
 	GetStore() store.BlockchainStore
+	ChainConfig() *ChainConfig
 }
 
 // TransactionPool is a thread-safe container for transactions received
@@ -54,11 +60,17 @@ func NewTransactionPool(config TransactionPoolConfig, chain blockchain) *Transac
 // AddTransaction adds a single transaction into the pool if it is valid and returns nil.
 // Otherwise, return the concrete error.
 func (pool *TransactionPool) AddTransaction(tx *types.Transaction) error {
-	statedb := pool.chain.CurrentState()
-	if err := tx.Validate(statedb); err != nil {
+	// validate the tx with the rules of the next block
+	block, statedb := pool.chain.CurrentBlock()
+	rules := pool.chain.ChainConfig().TxRules(block.Header.Height + 1)
+	if err := tx.Validate(statedb, rules); err != nil {
 		return err
 	}
 
+	if !rules.MultiSig && tx.IsMultiSigCreation() {
+		return errMultiSigDisabled
+	}
+
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
 
@@ -119,6 +131,23 @@ func (pool *TransactionPool) GetTransaction(txHash common.Hash) *types.Transacti
 	return pool.hashToTxMap[txHash]
 }
 
+// GetPendingNonce returns the next nonce of the account, which is the nonce in the current
+// state increased by the consecutive pending transactions of the account from that nonce.
+func (pool *TransactionPool) GetPendingNonce(account common.Address) uint64 {
+	nonce := pool.chain.CurrentState().GetNonce(account)
+
+	pool.mutex.RLock()
+	defer pool.mutex.RUnlock()
+
+	if collection := pool.accountToTxsMap[account]; collection != nil {
+		for collection.findTx(nonce) != nil {
+			nonce++
+		}
+	}
+
+	return nonce
+}
+
 // RemoveTransaction removes a transaction with the specified hash
 func (pool *TransactionPool) RemoveTransaction(txHash common.Hash) {
 	pool.mutex.Lock()
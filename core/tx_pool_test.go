@@ -40,7 +40,8 @@ func newTestTx(t *testing.T, amount int64, nonce uint64) *types.Transaction {
 }
 
 type mockBlockchain struct {
-	statedb *state.Statedb
+	statedb     *state.Statedb
+	chainConfig *ChainConfig
 }
 
 func newMockBlockchain() *mockBlockchain {
@@ -49,13 +50,21 @@ func newMockBlockchain() *mockBlockchain {
 		panic(err)
 	}
 
-	return &mockBlockchain{statedb}
+	return &mockBlockchain{statedb, DefaultChainConfig()}
+}
+
+func (chain mockBlockchain) CurrentBlock() (*types.Block, *state.Statedb) {
+	return &types.Block{Header: &types.BlockHeader{Height: 0}}, chain.statedb
 }
 
 func (chain mockBlockchain) CurrentState() *state.Statedb {
 	return chain.statedb
 }
 
+func (chain mockBlockchain) ChainConfig() *ChainConfig {
+	return chain.chainConfig
+}
+
 func (chain mockBlockchain) GetStore() store.BlockchainStore {
 	return chain.GetStore()
 }
@@ -78,6 +87,28 @@ func Test_TransactionPool_Add_ValidTx(t *testing.T) {
 	assert.Equal(t, len(pool.hashToTxMap), 1)
 }
 
+func Test_TransactionPool_Add_MultiSigTx(t *testing.T) {
+	chain := newMockBlockchain()
+	pool := NewTransactionPool(*DefaultTxPoolConfig(), chain)
+
+	fromPrivKey, fromAddress := randomAccount(t)
+	_, owner := randomAccount(t)
+	chain.addAccount(fromAddress, 20, 0)
+
+	tx, err := types.NewMultiSigTransaction(fromAddress, []common.Address{owner}, 1, big.NewInt(10), big.NewInt(0), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Sign(fromPrivKey)
+
+	// multisig is disabled by default
+	assert.Equal(t, pool.AddTransaction(tx), errMultiSigDisabled)
+
+	// multisig is enabled in the next block
+	chain.chainConfig.MultiSigHeight = big.NewInt(1)
+	assert.Equal(t, pool.AddTransaction(tx), error(nil))
+}
+
 func Test_TransactionPool_Add_InvalidTx(t *testing.T) {
 	chain := newMockBlockchain()
 	pool := NewTransactionPool(*DefaultTxPoolConfig(), chain)
@@ -197,3 +228,29 @@ func Test_TransactionPool_Remove(t *testing.T) {
 	pool.RemoveTransaction(tx.Hash)
 	assert.Equal(t, len(pool.accountToTxsMap), 0)
 }
+
+func Test_TransactionPool_GetPendingNonce(t *testing.T) {
+	chain := newMockBlockchain()
+	pool := NewTransactionPool(*DefaultTxPoolConfig(), chain)
+
+	fromPrivKey, fromAddress := randomAccount(t)
+	_, toAddress := randomAccount(t)
+	chain.addAccount(fromAddress, 100, 5)
+
+	// state nonce if no pending transactions
+	assert.Equal(t, pool.GetPendingNonce(fromAddress), uint64(5))
+
+	var txs []*types.Transaction
+	for nonce := uint64(5); nonce < 8; nonce++ {
+		tx, _ := types.NewTransaction(fromAddress, toAddress, big.NewInt(1), big.NewInt(0), nonce)
+		tx.Sign(fromPrivKey)
+		assert.Equal(t, pool.AddTransaction(tx), nil)
+		txs = append(txs, tx)
+	}
+
+	assert.Equal(t, pool.GetPendingNonce(fromAddress), uint64(8))
+
+	// the gap of removed transaction is filled first
+	pool.RemoveTransaction(txs[1].Hash)
+	assert.Equal(t, pool.GetPendingNonce(fromAddress), uint64(6))
+}
@@ -19,6 +19,7 @@ type BlockHeader struct {
 	StateHash         common.Hash    // StateHash is the root hash of the state trie
 	TxHash            common.Hash    // TxHash is the root hash of the transaction merkle tree
 	ReceiptHash       common.Hash    // ReceiptHash is the root hash of the receipt merkle tree
+	LogsBloom         Bloom          // LogsBloom is the combined bloom filter of the logs in all receipts
 	Difficulty        *big.Int       // Difficulty is the difficulty of the block
 	Height            uint64         // Height is the number of the block
 	CreateTimestamp   *big.Int       // CreateTimestamp is the timestamp when the block is created
@@ -73,6 +74,7 @@ func NewBlock(header *BlockHeader, txs []*Transaction, receipts []*Receipt) *Blo
 	}
 
 	block.Header.ReceiptHash = ReceiptMerkleRootHash(receipts)
+	block.Header.LogsBloom = CreateBloom(receipts)
 
 	// Calculate the block header hash.
 	block.HeaderHash = block.Header.Hash()
@@ -0,0 +1,88 @@
+/**
+* @file
+* @copyright defined in go-seele/LICENSE
+ */
+
+package types
+
+import (
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+const (
+	// BloomByteLength is the number of bytes used in a bloom filter.
+	BloomByteLength = 256
+
+	// bloomBitLength is the number of bits used in a bloom filter.
+	bloomBitLength = 8 * BloomByteLength
+)
+
+// Bloom represents a 2048 bits bloom filter to quickly check
+// whether a contract address or topic may be contained in logs.
+type Bloom [BloomByteLength]byte
+
+// Add adds the specified data into the bloom filter.
+func (b *Bloom) Add(data []byte) {
+	for _, index := range bloomIndexes(data) {
+		b[BloomByteLength-1-index/8] |= byte(1) << (index % 8)
+	}
+}
+
+// Or merges the specified bloom filter into this bloom filter.
+func (b *Bloom) Or(other Bloom) {
+	for i := range b {
+		b[i] |= other[i]
+	}
+}
+
+// Test indicates whether the specified data may be contained in the bloom filter.
+// Note, there may be false positive, but no false negative.
+func (b Bloom) Test(data []byte) bool {
+	for _, index := range bloomIndexes(data) {
+		if b[BloomByteLength-1-index/8]&(byte(1)<<(index%8)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bloomIndexes returns the 3 bit indexes in the bloom filter for the specified data.
+// Each index is the low-order 11 bits of a byte pair in the hash of the data.
+func bloomIndexes(data []byte) []uint {
+	hash := crypto.HashBytes(data).Bytes()
+	indexes := make([]uint, 3)
+
+	for i := range indexes {
+		indexes[i] = (uint(hash[2*i])<<8 | uint(hash[2*i+1])) % bloomBitLength
+	}
+
+	return indexes
+}
+
+// LogsBloom creates and returns the bloom filter of the specified logs,
+// including the contract address and topics of each log.
+func LogsBloom(logs []*Log) Bloom {
+	var bloom Bloom
+
+	for _, log := range logs {
+		bloom.Add(log.Address.Bytes())
+
+		for _, topic := range log.Topics {
+			bloom.Add(topic.Bytes())
+		}
+	}
+
+	return bloom
+}
+
+// CreateBloom creates and returns the combined bloom filter of the specified receipts.
+func CreateBloom(receipts []*Receipt) Bloom {
+	var bloom Bloom
+
+	for _, receipt := range receipts {
+		bloom.Or(receipt.Bloom)
+	}
+
+	return bloom
+}
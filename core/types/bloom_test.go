@@ -0,0 +1,50 @@
+/**
+* @file
+* @copyright defined in go-seele/LICENSE
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+)
+
+func Test_Bloom_AddAndTest(t *testing.T) {
+	var bloom Bloom
+	assert.Equal(t, bloom.Test([]byte("data")), false)
+
+	bloom.Add([]byte("data"))
+	assert.Equal(t, bloom.Test([]byte("data")), true)
+	assert.Equal(t, bloom.Test([]byte("other data")), false)
+}
+
+func Test_Bloom_LogsBloom(t *testing.T) {
+	log := &Log{
+		Address: common.BytesToAddress([]byte{1}),
+		Topics:  []common.Hash{common.StringToHash("topic1"), common.StringToHash("topic2")},
+	}
+
+	bloom := LogsBloom([]*Log{log})
+	assert.Equal(t, bloom.Test(log.Address.Bytes()), true)
+	assert.Equal(t, bloom.Test(log.Topics[0].Bytes()), true)
+	assert.Equal(t, bloom.Test(log.Topics[1].Bytes()), true)
+	assert.Equal(t, bloom.Test(common.StringToHash("topic3").Bytes()), false)
+
+	// empty logs
+	assert.Equal(t, LogsBloom(nil), Bloom{})
+}
+
+func Test_Bloom_CreateBloom(t *testing.T) {
+	r1 := &Receipt{}
+	r1.Bloom.Add([]byte("data1"))
+
+	r2 := &Receipt{}
+	r2.Bloom.Add([]byte("data2"))
+
+	bloom := CreateBloom([]*Receipt{r1, r2})
+	assert.Equal(t, bloom.Test([]byte("data1")), true)
+	assert.Equal(t, bloom.Test([]byte("data2")), true)
+}
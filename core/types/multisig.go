@@ -0,0 +1,137 @@
+/**
+* @file
+* @copyright defined in go-seele/LICENSE
+ */
+
+package types
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+// MaxMultiSigOwners is the max number of owners of a multisig account.
+const MaxMultiSigOwners = 16
+
+var (
+	// MultiSigCreationAddress is the reserved receiver address of the transaction to create
+	// a multisig account, whose payload is the RLP encoded MultiSigAccount. Note, it's only
+	// reserved since the multisig height in chain config.
+	MultiSigCreationAddress = common.BytesToAddress([]byte("multisig"))
+
+	// ErrMultiSigInvalid is returned when the owners or threshold of multisig account is invalid.
+	ErrMultiSigInvalid = errors.New("invalid multisig owners or threshold")
+
+	// ErrMultiSigNotEnough is returned when the signatures of multisig account are less than the threshold.
+	ErrMultiSigNotEnough = errors.New("multisig signatures not enough")
+
+	// ErrMultiSigUnexpected is returned when the multiple signatures are used for a non-multisig account.
+	ErrMultiSigUnexpected = errors.New("multiple signatures for non-multisig account")
+)
+
+// MultiSigAccount is the M-of-N authorization of a multisig account, which requires the
+// signatures of at least Threshold owners to spend from the account.
+type MultiSigAccount struct {
+	Threshold uint64           `json:"threshold"`
+	Owners    []common.Address `json:"owners"`
+}
+
+// Validate validates the owners and threshold of the multisig account.
+func (ms *MultiSigAccount) Validate() error {
+	if len(ms.Owners) == 0 || len(ms.Owners) > MaxMultiSigOwners {
+		return ErrMultiSigInvalid
+	}
+
+	if ms.Threshold == 0 || ms.Threshold > uint64(len(ms.Owners)) {
+		return ErrMultiSigInvalid
+	}
+
+	owners := make(map[common.Address]bool)
+	for _, owner := range ms.Owners {
+		if owner == (common.Address{}) || owners[owner] {
+			return ErrMultiSigInvalid
+		}
+
+		owners[owner] = true
+	}
+
+	return nil
+}
+
+// verify verifies the signatures against the owners, and each owner is counted at most once.
+func (ms *MultiSigAccount) verify(hash []byte, sigs []*crypto.Signature) error {
+	signed := make([]bool, len(ms.Owners))
+	var count uint64
+
+	for _, sig := range sigs {
+		if sig == nil {
+			return ErrSigInvalid
+		}
+
+		matched := false
+		for i := range ms.Owners {
+			if !signed[i] && sig.Verify(&ms.Owners[i], hash) {
+				signed[i], matched = true, true
+				count++
+				break
+			}
+		}
+
+		if !matched {
+			return ErrSigInvalid
+		}
+	}
+
+	if count < ms.Threshold {
+		return ErrMultiSigNotEnough
+	}
+
+	return nil
+}
+
+// NewMultiSigTransaction returns a transaction to create a multisig account with the owners
+// and threshold, and the amount is transferred to the created account. The multisig account
+// address is derived from the sender address and nonce, see crypto.CreateAddress.
+func NewMultiSigTransaction(from common.Address, owners []common.Address, threshold uint64, amount *big.Int, fee *big.Int, nonce uint64) (*Transaction, error) {
+	ms := &MultiSigAccount{threshold, owners}
+	if err := ms.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newTx(from, &MultiSigCreationAddress, amount, fee, nonce, common.SerializePanic(ms))
+}
+
+// IsMultiSigCreation indicates whether the transaction is to create a multisig account.
+func (tx *Transaction) IsMultiSigCreation() bool {
+	return tx.Data != nil && tx.Data.To != nil && tx.Data.To.Equal(MultiSigCreationAddress)
+}
+
+// GetMultiSigAccount decodes the multisig account of the creation transaction.
+func (tx *Transaction) GetMultiSigAccount() (*MultiSigAccount, error) {
+	ms := &MultiSigAccount{}
+	if err := common.Deserialize(tx.Data.Payload, ms); err != nil {
+		return nil, ErrMultiSigInvalid
+	}
+
+	if err := ms.Validate(); err != nil {
+		return nil, err
+	}
+
+	return ms, nil
+}
+
+// CoSign appends the signature of an owner to the transaction of multisig account.
+// The transaction hash is not recalculated, so that all owners sign the same data. The
+// signature is recoverable, so that both the long and short owner addresses are verified.
+func (tx *Transaction) CoSign(privKey *ecdsa.PrivateKey) error {
+	if !crypto.MustHash(tx.Data).Equal(tx.Hash) {
+		return ErrHashMismatch
+	}
+
+	tx.Signatures = append(tx.Signatures, crypto.NewRecoverableSignature(privKey, tx.Hash.Bytes()))
+	return nil
+}
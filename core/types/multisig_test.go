@@ -0,0 +1,118 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package types
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+)
+
+func newTestMultiSig(t *testing.T, threshold uint64, n int) (*MultiSigAccount, []*ecdsa.PrivateKey) {
+	ms := &MultiSigAccount{Threshold: threshold}
+	var keys []*ecdsa.PrivateKey
+
+	for i := 0; i < n; i++ {
+		key, addr := randomAccount(t)
+		keys = append(keys, key)
+		ms.Owners = append(ms.Owners, addr)
+	}
+
+	return ms, keys
+}
+
+func Test_MultiSigAccount_Validate(t *testing.T) {
+	ms, _ := newTestMultiSig(t, 2, 3)
+	assert.Equal(t, ms.Validate(), nil)
+
+	invalid := []*MultiSigAccount{
+		{Threshold: 0, Owners: ms.Owners},
+		{Threshold: 4, Owners: ms.Owners},
+		{Threshold: 1, Owners: nil},
+		{Threshold: 1, Owners: []common.Address{ms.Owners[0], ms.Owners[0]}},
+		{Threshold: 1, Owners: []common.Address{{}}},
+	}
+
+	for _, m := range invalid {
+		assert.Equal(t, m.Validate(), ErrMultiSigInvalid)
+	}
+}
+
+func Test_Transaction_MultiSigCreation(t *testing.T) {
+	ms, _ := newTestMultiSig(t, 2, 3)
+	privKey, from := randomAccount(t)
+
+	tx, err := NewMultiSigTransaction(from, ms.Owners, ms.Threshold, big.NewInt(10), big.NewInt(1), 0)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, tx.IsMultiSigCreation(), true)
+	tx.Sign(privKey)
+
+	decoded, err := tx.GetMultiSigAccount()
+	assert.Equal(t, err, nil)
+	assert.Equal(t, decoded, ms)
+	assert.Equal(t, tx.Validate(newTestStateDB(from, 0, 10), TxRules{MultiSig: true}), nil)
+
+	_, err = NewMultiSigTransaction(from, ms.Owners, 4, big.NewInt(10), big.NewInt(1), 0)
+	assert.Equal(t, err, ErrMultiSigInvalid)
+}
+
+func Test_Transaction_Validate_MultiSigCreation(t *testing.T) {
+	privKey, from := randomAccount(t)
+
+	// invalid multisig account in payload
+	tx, _ := NewMessageTransaction(from, MultiSigCreationAddress, big.NewInt(10), big.NewInt(1), 0, []byte("invalid"))
+	tx.Sign(privKey)
+
+	// a normal transfer if multisig is disabled
+	assert.Equal(t, tx.IsMultiSigCreation(), true)
+	assert.Equal(t, tx.Validate(newTestStateDB(from, 0, 10), TxRules{}), nil)
+	assert.Equal(t, tx.Validate(newTestStateDB(from, 0, 10), TxRules{MultiSig: true}), ErrMultiSigInvalid)
+}
+
+func Test_Transaction_Validate_MultiSig(t *testing.T) {
+	ms, keys := newTestMultiSig(t, 2, 3)
+	_, from := randomAccount(t)
+	statedb := newTestStateDB(from, 0, 10)
+	statedb.multiSigs = map[common.Address]*MultiSigAccount{from: ms}
+
+	tx, _ := NewTransaction(from, randomAddress(t), big.NewInt(1), big.NewInt(0), 0)
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), ErrSigMissing)
+
+	assert.Equal(t, tx.CoSign(keys[2]), nil)
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), ErrMultiSigNotEnough)
+
+	// the same owner is counted only once
+	assert.Equal(t, tx.CoSign(keys[2]), nil)
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), ErrSigInvalid)
+
+	tx.Signatures = tx.Signatures[:1]
+	assert.Equal(t, tx.CoSign(keys[0]), nil)
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), nil)
+
+	// partially signed tx file is encoded with the signatures
+	decoded := new(Transaction)
+	assert.Equal(t, common.Deserialize(common.SerializePanic(tx), decoded), nil)
+	assert.Equal(t, len(decoded.Signatures), 2)
+	assert.Equal(t, decoded.Signature == nil, true)
+	assert.Equal(t, decoded.Validate(statedb, TxRules{}), nil)
+
+	// signed by non-owner
+	otherKey, _ := randomAccount(t)
+	assert.Equal(t, tx.CoSign(otherKey), nil)
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), ErrSigInvalid)
+
+	// single signature is not allowed for multisig account
+	tx.Signatures = nil
+	tx.Sign(keys[0])
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), ErrSigMissing)
+
+	// multiple signatures are not allowed for the other accounts
+	tx.Signatures = decoded.Signatures
+	assert.Equal(t, tx.Validate(newTestStateDB(from, 0, 10), TxRules{}), ErrMultiSigUnexpected)
+}
@@ -18,6 +18,7 @@ type Receipt struct {
 	Result          []byte         // the execution result of the tx
 	PostState       common.Hash    // the root hash of the state trie after the tx is processed.
 	Logs            []*Log         // the log objects
+	Bloom           Bloom          // the bloom filter of the logs
 	TxHash          common.Hash    // the hash of the executed transaction
 	ContractAddress common.Address // Used when the tx (nil To address) is to create a contract.
 }
@@ -61,7 +62,7 @@ func ReceiptMerkleRootHash(receipts []*Receipt) common.Hash {
 
 // MakeRewardReceipt generates the receipt for the specified reward transaction
 func MakeRewardReceipt(reward *Transaction) *Receipt {
-    return &Receipt{
-	    TxHash: reward.Hash,
+	return &Receipt{
+		TxHash: reward.Hash,
 	}
 }
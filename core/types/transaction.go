@@ -6,12 +6,15 @@
 package types
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/merkle"
@@ -49,8 +52,14 @@ var (
 	// ErrSigMissing is returned when the transaction signature is missing.
 	ErrSigMissing = errors.New("signature missing")
 
+	// ErrShortAddressDisabled is returned when the transaction has short address but it is disabled in chain config.
+	ErrShortAddressDisabled = errors.New("short address is disabled")
+
 	emptyTxRootHash = crypto.MustHash("empty transaction root hash")
 
+	// emptyList is the RLP encoded empty list of nil signature.
+	emptyList = []byte{0xc0}
+
 	// MaxPayloadSize limits the payload size to prevent malicious transactions.
 	MaxPayloadSize = defaultMaxPayloadSize
 )
@@ -58,7 +67,7 @@ var (
 // TransactionData wraps the data in a transaction.
 type TransactionData struct {
 	From         common.Address  // From is the address of the sender
-	To           *common.Address // To is the receiver address, which is nil for contract creation transaction
+	To           *common.Address `rlp:"nil"` // To is the receiver address, which is nil for contract creation transaction
 	Amount       *big.Int        // Amount is the amount to be transferred
 	AccountNonce uint64          // AccountNonce is the nonce of the sender account
 	Fee          *big.Int        // Transaction Fee
@@ -70,7 +79,68 @@ type TransactionData struct {
 type Transaction struct {
 	Hash      common.Hash       // Hash is the hash of the transaction data
 	Data      *TransactionData  // Data is the transaction data
-	Signature *crypto.Signature // Signature is the signature of the transaction
+	Signature *crypto.Signature // Signature is the signature of the transaction, which is nil for multisig account
+
+	// Signatures are the signatures of the multisig account owners, see MultiSigAccount.
+	Signatures []*crypto.Signature `json:",omitempty"`
+}
+
+// EncodeRLP implements rlp.Encoder. The signatures of multisig account are appended
+// only if any, so that the other transactions are encoded as before.
+func (tx *Transaction) EncodeRLP(w io.Writer) error {
+	fields := []interface{}{tx.Hash, tx.Data}
+	if tx.Signature == nil {
+		fields = append(fields, []interface{}{})
+	} else {
+		fields = append(fields, tx.Signature)
+	}
+
+	for _, sig := range tx.Signatures {
+		fields = append(fields, sig)
+	}
+
+	return rlp.Encode(w, fields)
+}
+
+// DecodeRLP implements rlp.Decoder, and the signature is nil if encoded as an empty list.
+func (tx *Transaction) DecodeRLP(s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+
+	*tx = Transaction{}
+	if err := s.Decode(&tx.Hash); err != nil {
+		return err
+	}
+
+	if err := s.Decode(&tx.Data); err != nil {
+		return err
+	}
+
+	rawSig, err := s.Raw()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(rawSig, emptyList) {
+		tx.Signature = new(crypto.Signature)
+		if err = rlp.DecodeBytes(rawSig, tx.Signature); err != nil {
+			return err
+		}
+	}
+
+	for {
+		sig := new(crypto.Signature)
+		if err = s.Decode(sig); err == rlp.EOL {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		tx.Signatures = append(tx.Signatures, sig)
+	}
+
+	return s.ListEnd()
 }
 
 // TxIndex represents an index that used to query block info by tx hash.
@@ -82,6 +152,7 @@ type TxIndex struct {
 type stateDB interface {
 	GetBalance(common.Address) *big.Int
 	GetNonce(common.Address) uint64
+	GetMultiSig(common.Address) *MultiSigAccount
 }
 
 // NewTransaction creates a new transaction to transfer asset.
@@ -129,7 +200,7 @@ func newTx(from common.Address, to *common.Address, amount *big.Int, fee *big.In
 		txData.Payload = make([]byte, 0)
 	}
 
-	return &Transaction{crypto.MustHash(txData), txData, nil}, nil
+	return &Transaction{crypto.MustHash(txData), txData, nil, nil}, nil
 }
 
 // NewContractTransaction returns a transaction to create a smart contract.
@@ -142,14 +213,28 @@ func NewMessageTransaction(from, to common.Address, amount *big.Int, fee *big.In
 	return newTx(from, &to, amount, fee, nonce, msg)
 }
 
-// Sign signs the transaction with the specified private key.
+// Sign signs the transaction with the specified private key. The signature is recoverable
+// if the sender is a short address, so that the sender could be verified with the signature.
 func (tx *Transaction) Sign(privKey *ecdsa.PrivateKey) {
 	tx.Hash = crypto.MustHash(tx.Data)
-	tx.Signature = crypto.NewSignature(privKey, tx.Hash.Bytes())
+	if tx.Data.From.IsShort() {
+		tx.Signature = crypto.NewRecoverableSignature(privKey, tx.Hash.Bytes())
+	} else {
+		tx.Signature = crypto.NewSignature(privKey, tx.Hash.Bytes())
+	}
+}
+
+// TxRules is the rules to validate transactions, which are determined by the chain config.
+type TxRules struct {
+	// ShortAddress indicates whether the short address is allowed.
+	ShortAddress bool
+
+	// MultiSig indicates whether the transaction to MultiSigCreationAddress creates a multisig account.
+	MultiSig bool
 }
 
 // Validate returns true if the transaction is valid, otherwise false.
-func (tx *Transaction) Validate(statedb stateDB) error {
+func (tx *Transaction) Validate(statedb stateDB, rules TxRules) error {
 	if tx.Data == nil || tx.Data.Amount == nil {
 		return ErrAmountNil
 	}
@@ -158,11 +243,16 @@ func (tx *Transaction) Validate(statedb stateDB) error {
 		return ErrAmountNegative
 	}
 
+	if !rules.ShortAddress && (tx.Data.From.IsShort() || (tx.Data.To != nil && tx.Data.To.IsShort())) {
+		return ErrShortAddressDisabled
+	}
+
 	if fromShardNum := common.GetShardNumber(tx.Data.From); fromShardNum != common.LocalShardNumber {
 		return fmt.Errorf("invalid from address, shard number is [%v], but coinbase shard number is [%v]", fromShardNum, common.LocalShardNumber)
 	}
 
-	if tx.Data.To != nil {
+	multiSigCreation := rules.MultiSig && tx.IsMultiSigCreation()
+	if tx.Data.To != nil && !multiSigCreation {
 		if toShardNum := common.GetShardNumber(*tx.Data.To); toShardNum != common.LocalShardNumber {
 			return fmt.Errorf("invalid to address, shard number is [%v], but coinbase shard number is [%v]", toShardNum, common.LocalShardNumber)
 		}
@@ -180,7 +270,18 @@ func (tx *Transaction) Validate(statedb stateDB) error {
 		return ErrPayloadOversized
 	}
 
-	if tx.Signature == nil {
+	if multiSigCreation {
+		if _, err := tx.GetMultiSigAccount(); err != nil {
+			return err
+		}
+	}
+
+	multiSig := statedb.GetMultiSig(tx.Data.From)
+	if multiSig == nil && len(tx.Signatures) > 0 {
+		return ErrMultiSigUnexpected
+	}
+
+	if (multiSig == nil && tx.Signature == nil) || (multiSig != nil && len(tx.Signatures) == 0) {
 		return ErrSigMissing
 	}
 
@@ -189,6 +290,14 @@ func (tx *Transaction) Validate(statedb stateDB) error {
 		return ErrHashMismatch
 	}
 
+	if multiSig != nil {
+		if tx.Signature != nil {
+			return ErrSigInvalid
+		}
+
+		return multiSig.verify(txDataHash.Bytes(), tx.Signatures)
+	}
+
 	if !tx.Signature.Verify(&tx.Data.From, txDataHash.Bytes()) {
 		return ErrSigInvalid
 	}
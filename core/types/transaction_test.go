@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/magiconair/properties/assert"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/crypto"
@@ -48,8 +49,9 @@ func newTestTx(t *testing.T, amount int64, nonce uint64, sign bool) *Transaction
 }
 
 type mockStateDB struct {
-	balances map[common.Address]*big.Int
-	nonces   map[common.Address]uint64
+	balances  map[common.Address]*big.Int
+	nonces    map[common.Address]uint64
+	multiSigs map[common.Address]*MultiSigAccount
 }
 
 func (db *mockStateDB) GetBalance(address common.Address) *big.Int {
@@ -68,6 +70,10 @@ func (db *mockStateDB) GetNonce(address common.Address) uint64 {
 	return 0
 }
 
+func (db *mockStateDB) GetMultiSig(address common.Address) *MultiSigAccount {
+	return db.multiSigs[address]
+}
+
 func newTestStateDB(address common.Address, nonce, balance uint64) *mockStateDB {
 	return &mockStateDB{
 		balances: map[common.Address]*big.Int{address: new(big.Int).SetUint64(balance)},
@@ -79,7 +85,7 @@ func newTestStateDB(address common.Address, nonce, balance uint64) *mockStateDB
 func Test_Transaction_Validate_NoDataChange(t *testing.T) {
 	tx := newTestTx(t, 100, 38, true)
 	statedb := newTestStateDB(tx.Data.From, 38, 200)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, error(nil))
 }
 
@@ -87,7 +93,7 @@ func Test_Transaction_Validate_NoDataChange(t *testing.T) {
 func Test_Transaction_Validate_NotSigned(t *testing.T) {
 	tx := newTestTx(t, 100, 38, false)
 	statedb := newTestStateDB(tx.Data.From, 38, 200)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, ErrSigMissing)
 }
 
@@ -96,7 +102,7 @@ func Test_Transaction_Validate_HashChanged(t *testing.T) {
 	tx := newTestTx(t, 100, 38, true)
 	tx.Hash = crypto.HashBytes([]byte("test"))
 	statedb := newTestStateDB(tx.Data.From, 38, 200)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, ErrHashMismatch)
 }
 
@@ -105,7 +111,7 @@ func Test_Transaction_Validate_TxDataChanged(t *testing.T) {
 	tx := newTestTx(t, 100, 38, true)
 	tx.Data.Amount.SetInt64(200)
 	statedb := newTestStateDB(tx.Data.From, 38, 200)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, ErrHashMismatch)
 }
 
@@ -118,7 +124,7 @@ func Test_Transaction_Validate_SignInvalid(t *testing.T) {
 	tx.Hash = crypto.MustHash(tx.Data)
 
 	statedb := newTestStateDB(tx.Data.From, 38, 200)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 
 	assert.Equal(t, err, ErrSigInvalid)
 }
@@ -131,14 +137,14 @@ func Test_MerkleRootHash_Empty(t *testing.T) {
 func Test_Transaction_Validate_BalanceNotEnough(t *testing.T) {
 	tx := newTestTx(t, 100, 38, true)
 	statedb := newTestStateDB(tx.Data.From, 38, 50)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, ErrBalanceNotEnough)
 }
 
 func Test_Transaction_Validate_NonceTooLow(t *testing.T) {
 	tx := newTestTx(t, 100, 38, true)
 	statedb := newTestStateDB(tx.Data.From, 40, 200)
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, ErrNonceTooLow)
 }
 
@@ -157,7 +163,7 @@ func Test_Transaction_Validate_PayloadOversized(t *testing.T) {
 
 	statedb := newTestStateDB(tx.Data.From, 38, 200)
 
-	err = tx.Validate(statedb)
+	err = tx.Validate(statedb, TxRules{})
 	assert.Equal(t, err, ErrPayloadOversized)
 }
 
@@ -184,7 +190,7 @@ func Test_Transaction_Validate_InvalidFromShard(t *testing.T) {
 
 	statedb := newTestStateDB(tx.Data.From, 5, 100)
 
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, strings.Contains(err.Error(), "invalid from address"), true)
 }
 
@@ -198,7 +204,7 @@ func Test_Transaction_Validate_InvalidToShard(t *testing.T) {
 
 	statedb := newTestStateDB(tx.Data.From, 5, 100)
 
-	err := tx.Validate(statedb)
+	err := tx.Validate(statedb, TxRules{})
 	assert.Equal(t, strings.Contains(err.Error(), "invalid to address"), true)
 }
 
@@ -215,7 +221,7 @@ func Test_Transaction_Validate_InvalidContractShard(t *testing.T) {
 
 	statedb := newTestStateDB(tx.Data.From, 5, 100)
 
-	err = tx.Validate(statedb)
+	err = tx.Validate(statedb, TxRules{})
 	assert.Equal(t, strings.Contains(err.Error(), "invalid to address"), true)
 }
 
@@ -230,3 +236,70 @@ func Test_Transaction_InvalidFee(t *testing.T) {
 	assert.Equal(t, tx, (*Transaction)(nil))
 	assert.Equal(t, err, ErrFeeNegative)
 }
+
+func Test_Transaction_RLP(t *testing.T) {
+	fromPrivKey, fromAddress := randomAccount(t)
+	to := randomAddress(t)
+
+	transfer, _ := NewTransaction(fromAddress, to, big.NewInt(1), big.NewInt(2), 3)
+	contract, _ := NewContractTransaction(fromAddress, big.NewInt(1), big.NewInt(2), 3, []byte("code"))
+
+	for _, tx := range []*Transaction{transfer, contract} {
+		tx.Sign(fromPrivKey)
+
+		encoded, err := common.Serialize(tx)
+		assert.Equal(t, err, nil)
+
+		decoded := new(Transaction)
+		assert.Equal(t, common.Deserialize(encoded, decoded), nil)
+		assert.Equal(t, decoded.Hash, tx.Hash)
+		assert.Equal(t, decoded.Data.To, tx.Data.To)
+		assert.Equal(t, decoded.Validate(newTestStateDB(fromAddress, 3, 10), TxRules{}), nil)
+	}
+}
+
+func Test_TransactionData_RLP_NilTo(t *testing.T) {
+	from := randomAddress(t)
+
+	// nil receiver is encoded as empty string as before, and decoded as nil instead of zero address
+	data := TransactionData{From: from, Amount: big.NewInt(1), Fee: big.NewInt(0)}
+	encoded, err := common.Serialize(&data)
+	assert.Equal(t, err, nil)
+
+	var fields []rlp.RawValue
+	assert.Equal(t, rlp.DecodeBytes(encoded, &fields), nil)
+	assert.Equal(t, []byte(fields[1]), []byte{0x80})
+
+	decoded := TransactionData{}
+	assert.Equal(t, common.Deserialize(encoded, &decoded), nil)
+	assert.Equal(t, decoded.To, (*common.Address)(nil))
+
+	// zero address receiver is still decoded as zero address
+	data.To = &common.Address{}
+	encoded, err = common.Serialize(&data)
+	assert.Equal(t, err, nil)
+
+	decoded = TransactionData{}
+	assert.Equal(t, common.Deserialize(encoded, &decoded), nil)
+	assert.Equal(t, decoded.To, &common.Address{})
+}
+
+func Test_Transaction_Validate_ShortAddress(t *testing.T) {
+	privKey, _ := randomAccount(t)
+	from := *crypto.GetShortAddress(privKey)
+
+	tx, _ := NewTransaction(from, randomAddress(t), big.NewInt(1), big.NewInt(0), 0)
+	tx.Sign(privKey)
+	assert.Equal(t, tx.Signature.V != nil, true)
+
+	statedb := newTestStateDB(from, 0, 10)
+	assert.Equal(t, tx.Validate(statedb, TxRules{}), ErrShortAddressDisabled)
+
+	rules := TxRules{ShortAddress: true}
+	assert.Equal(t, tx.Validate(statedb, rules), nil)
+
+	// signed by another key
+	otherKey, _ := randomAccount(t)
+	tx.Signature = crypto.NewRecoverableSignature(otherKey, tx.Hash.Bytes())
+	assert.Equal(t, tx.Validate(statedb, rules), ErrSigInvalid)
+}
@@ -149,6 +149,20 @@ func MustGetAddress(key *ecdsa.PrivateKey) *common.Address {
 	return addr
 }
 
+// PubkeyToShortAddress returns the short address of the public key,
+// which is the last bytes of the hash of public key.
+func PubkeyToShortAddress(pub *ecdsa.PublicKey) common.Address {
+	hash := HashBytes(FromECDSAPub(pub)[1:])
+	addr, _ := common.NewShortAddress(hash[len(hash)-common.ShortAddressLength:])
+	return addr
+}
+
+// GetShortAddress gets the short address from the given private key
+func GetShortAddress(key *ecdsa.PrivateKey) *common.Address {
+	addr := PubkeyToShortAddress(&key.PublicKey)
+	return &addr
+}
+
 // GenerateRandomAddress generates and returns a random address.
 func GenerateRandomAddress() (*common.Address, error) {
 	publicKey, _, error := GenerateKeyPair()
@@ -188,8 +202,8 @@ func MustGenerateShardAddress(shardNum uint) *common.Address {
 
 // CreateAddress creates a new address with the specified address and nonce.
 // Generally, it's used to create a new contract address based on the account
-// address and nonce. Note, the new created contract address and the account
-// address are in the same shard.
+// address and nonce, so that it could be predicted before the contract is created.
+// Note, the new created contract address and the account address are in the same shard.
 func CreateAddress(addr common.Address, nonce uint64) common.Address {
 	addrHash := MustHash(addr)
 	nonceHash := MustHash(nonce)
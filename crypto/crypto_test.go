@@ -32,6 +32,11 @@ func Test_CreateAddress(t *testing.T) {
 	addr1 = CreateAddress(common.BytesToAddress([]byte{6}), 9)
 	addr2 = CreateAddress(common.BytesToAddress([]byte{6}), 9)
 	assert.Equal(t, true, addr1.Equal(addr2))
+
+	// The contract address derivation must not change for the existing chains.
+	addr1 = CreateAddress(common.BytesToAddress([]byte{1}), 4)
+	addr2 = common.HexMustToAddres("0x565c3c388000d293c435d8834210ce50f6dd53b42ec45bc68354cb38f30d406bf343681465b9efe82c933c3e8748c70cb8aa06539c361de20f72eac00000000b")
+	assert.Equal(t, addr1, addr2)
 }
 
 func Test_CreateAddress_Shard(t *testing.T) {
@@ -0,0 +1,72 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/seeleteam/go-seele/common"
+)
+
+// messagePrefix is prepended to the personal message before hashing, so that a
+// signed message could never be a valid transaction or other signed data.
+const messagePrefix = "\x19Seele Signed Message:\n"
+
+// signatureLength is the length of the serialized signature, R and S in 32 bytes each,
+// and followed by 1 byte recovery id for the recoverable signature.
+const signatureLength = 64
+
+var errInvalidSignature = errors.New("invalid signature length, it should be 64 or 65 bytes")
+
+// MessageHash returns the hash of the personal message to sign, which is
+// the hash of prefix, the decimal message length and message.
+func MessageHash(message []byte) common.Hash {
+	return HashBytes([]byte(messagePrefix+strconv.Itoa(len(message))), message)
+}
+
+// SignMessage signs the personal message with private key, and the signature is
+// recoverable so that it could be verified against the short address.
+func SignMessage(privKey *ecdsa.PrivateKey, message []byte) *Signature {
+	return NewRecoverableSignature(privKey, MessageHash(message).Bytes())
+}
+
+// VerifyMessage verifies the signature of the personal message against the signer address.
+func VerifyMessage(signerAddress *common.Address, message []byte, sig *Signature) bool {
+	return sig.Verify(signerAddress, MessageHash(message).Bytes())
+}
+
+// Bytes returns the bytes of the signature, R and S in 32 bytes each,
+// and followed by the recovery id if recoverable.
+func (sig *Signature) Bytes() []byte {
+	b := append(math.PaddedBigBytes(sig.R, 32), math.PaddedBigBytes(sig.S, 32)...)
+	if sig.V != nil {
+		b = append(b, byte(sig.V.Uint64()))
+	}
+
+	return b
+}
+
+// BytesToSignature converts the bytes of R, S and the optional recovery id to signature.
+func BytesToSignature(b []byte) (*Signature, error) {
+	if len(b) != signatureLength && len(b) != signatureLength+1 {
+		return nil, errInvalidSignature
+	}
+
+	sig := &Signature{
+		R: new(big.Int).SetBytes(b[:32]),
+		S: new(big.Int).SetBytes(b[32:64]),
+	}
+
+	if len(b) > signatureLength {
+		sig.V = big.NewInt(int64(b[64]))
+	}
+
+	return sig, nil
+}
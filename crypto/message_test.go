@@ -0,0 +1,39 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_SignMessage(t *testing.T) {
+	address, privateKey, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := []byte("I own this address")
+	sig := SignMessage(privateKey, message)
+	assert.Equal(t, VerifyMessage(address, message, sig), true)
+	assert.Equal(t, VerifyMessage(address, []byte("I own that address"), sig), false)
+	assert.Equal(t, VerifyMessage(MustGenerateRandomAddress(), message, sig), false)
+
+	// domain separated from the raw hash signature
+	assert.Equal(t, sig.Verify(address, HashBytes(message).Bytes()), false)
+
+	decoded, err := BytesToSignature(sig.Bytes())
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(sig.Bytes()), 65)
+	assert.Equal(t, VerifyMessage(address, message, decoded), true)
+
+	_, err = BytesToSignature(sig.Bytes()[2:])
+	assert.Equal(t, err, errInvalidSignature)
+
+	// verified against the short address
+	assert.Equal(t, VerifyMessage(GetShortAddress(privateKey), message, sig), true)
+}
@@ -8,16 +8,24 @@ package crypto
 import (
 	"crypto/ecdsa"
 	"crypto/rand"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/crypto/secp256k1"
 )
 
+var errNotRecoverable = errors.New("signature is not recoverable")
+
 // Signature is a wrapper for the signed message and it is serializable.
 type Signature struct {
 	R *big.Int // Signature of elliptic curve cryptography.
 	S *big.Int // Signature of elliptic curve cryptography.
+	V *big.Int `json:",omitempty"` // Recovery id of the recoverable signature, nil for the legacy signature.
 }
 
 // NewSignature signs the specified hash with private key and returns a signature.
@@ -28,12 +36,98 @@ func NewSignature(privKey *ecdsa.PrivateKey, hash []byte) *Signature {
 		panic(fmt.Errorf("Failed to sign hash, private key = %+v, hash = %v, error = %v", privKey, hash, err.Error()))
 	}
 
-	return &Signature{r, s}
+	return &Signature{R: r, S: s}
+}
+
+// NewRecoverableSignature signs the specified 32 bytes hash with private key and returns
+// a signature with recovery id, from which the public key of signer could be recovered.
+// Panic if failed to sign the hash.
+func NewRecoverableSignature(privKey *ecdsa.PrivateKey, hash []byte) *Signature {
+	sig, err := secp256k1.Sign(hash, math.PaddedBigBytes(privKey.D, 32))
+	if err != nil {
+		panic(fmt.Errorf("Failed to sign hash, hash = %v, error = %v", hash, err.Error()))
+	}
+
+	return &Signature{
+		R: new(big.Int).SetBytes(sig[:32]),
+		S: new(big.Int).SetBytes(sig[32:64]),
+		V: big.NewInt(int64(sig[64])),
+	}
 }
 
 // Verify verifies the signature against the specified hash.
 // Return true if the signature is valid, otherwise false.
+// The short address could only be verified with the recoverable signature.
 func (sig *Signature) Verify(signerAddress *common.Address, hash []byte) bool {
+	if signerAddress.IsShort() {
+		pubKey, err := sig.RecoverPubkey(hash)
+		return err == nil && PubkeyToShortAddress(pubKey) == *signerAddress
+	}
+
 	pubKey := ToECDSAPub(signerAddress.Bytes())
 	return ecdsa.Verify(pubKey, hash, sig.R, sig.S)
 }
+
+// RecoverPubkey returns the public key of the signer of the recoverable signature.
+func (sig *Signature) RecoverPubkey(hash []byte) (*ecdsa.PublicKey, error) {
+	if sig.V == nil || !sig.V.IsUint64() || sig.V.Uint64() > 3 || sig.R == nil || sig.S == nil || sig.R.BitLen() > 256 || sig.S.BitLen() > 256 {
+		return nil, errNotRecoverable
+	}
+
+	compact := make([]byte, 65)
+	copy(compact, math.PaddedBigBytes(sig.R, 32))
+	copy(compact[32:], math.PaddedBigBytes(sig.S, 32))
+	compact[64] = byte(sig.V.Uint64())
+
+	pub, err := secp256k1.RecoverPubkey(hash, compact)
+	if err != nil {
+		return nil, err
+	}
+
+	return ToECDSAPub(pub), nil
+}
+
+// RecoverShortAddress returns the short address of the signer of the recoverable signature.
+func (sig *Signature) RecoverShortAddress(hash []byte) (common.Address, error) {
+	pubKey, err := sig.RecoverPubkey(hash)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return PubkeyToShortAddress(pubKey), nil
+}
+
+// EncodeRLP implements rlp.Encoder. The legacy signature is encoded
+// without recovery id, so that it is compatible with the legacy format.
+func (sig *Signature) EncodeRLP(w io.Writer) error {
+	if sig.V == nil {
+		return rlp.Encode(w, []*big.Int{sig.R, sig.S})
+	}
+
+	return rlp.Encode(w, []*big.Int{sig.R, sig.S, sig.V})
+}
+
+// DecodeRLP implements rlp.Decoder, and the recovery id is optional.
+func (sig *Signature) DecodeRLP(s *rlp.Stream) error {
+	if _, err := s.List(); err != nil {
+		return err
+	}
+
+	sig.R, sig.S, sig.V = new(big.Int), new(big.Int), nil
+	if err := s.Decode(sig.R); err != nil {
+		return err
+	}
+
+	if err := s.Decode(sig.S); err != nil {
+		return err
+	}
+
+	v := new(big.Int)
+	if err := s.Decode(v); err == nil {
+		sig.V = v
+	} else if err != rlp.EOL {
+		return err
+	}
+
+	return s.ListEnd()
+}
@@ -0,0 +1,72 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+)
+
+func Test_RecoverableSignature(t *testing.T) {
+	address, privateKey, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := HashBytes([]byte("data")).Bytes()
+	sig := NewRecoverableSignature(privateKey, hash)
+
+	pubKey, err := sig.RecoverPubkey(hash)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, FromECDSAPub(pubKey), FromECDSAPub(&privateKey.PublicKey))
+
+	shortAddress, err := sig.RecoverShortAddress(hash)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, shortAddress, *GetShortAddress(privateKey))
+	assert.Equal(t, shortAddress.IsShort(), true)
+
+	// verified against both the public key address and short address
+	assert.Equal(t, sig.Verify(address, hash), true)
+	assert.Equal(t, sig.Verify(&shortAddress, hash), true)
+	assert.Equal(t, sig.Verify(GetShortAddress(privateKey), HashBytes([]byte("other")).Bytes()), false)
+
+	// the legacy signature could not be verified against the short address
+	legacy := NewSignature(privateKey, hash)
+	assert.Equal(t, legacy.Verify(address, hash), true)
+	assert.Equal(t, legacy.Verify(&shortAddress, hash), false)
+
+	_, err = legacy.RecoverPubkey(hash)
+	assert.Equal(t, err, errNotRecoverable)
+}
+
+func Test_Signature_RLP(t *testing.T) {
+	_, privateKey, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := HashBytes([]byte("data")).Bytes()
+
+	// the legacy signature is encoded as before
+	legacy := NewSignature(privateKey, hash)
+	encoded := common.SerializePanic(legacy)
+	assert.Equal(t, encoded, common.SerializePanic(struct{ R, S *big.Int }{legacy.R, legacy.S}))
+
+	decoded := new(Signature)
+	assert.Equal(t, common.Deserialize(encoded, decoded), nil)
+	assert.Equal(t, decoded.V == nil, true)
+	assert.Equal(t, decoded.R, legacy.R)
+	assert.Equal(t, decoded.S, legacy.S)
+
+	sig := NewRecoverableSignature(privateKey, hash)
+	decoded = new(Signature)
+	assert.Equal(t, common.Deserialize(common.SerializePanic(sig), decoded), nil)
+	assert.Equal(t, decoded.Bytes(), sig.Bytes())
+	assert.Equal(t, decoded.Verify(GetShortAddress(privateKey), hash), true)
+}
@@ -21,5 +21,6 @@ var BlockMinedEventManager = NewEventManager()
 // TransactionInsertedEventManager represents the event that a new transaction is inserted into txpool
 var TransactionInsertedEventManager = NewEventManager()
 
-// BlockInsertedEventManager represents the event that a new block is inserted into the blockchain
+// BlockInsertedEventManager represents the event that a new block is inserted into the blockchain,
+// and becomes the HEAD block of the canonical chain.
 var BlockInsertedEventManager = NewEventManager()
@@ -21,7 +21,10 @@ var (
 )
 
 // Engine provides the consensus operations based on POW.
-type Engine struct{}
+type Engine struct {
+	// Rewards is the miner reward schedule, and the default schedule is used if nil.
+	Rewards *RewardSchedule
+}
 
 // ValidateHeader validates the specified header and returns error if validation failed.
 func (engine Engine) ValidateHeader(blockHeader *types.BlockHeader) error {
@@ -40,7 +43,7 @@ func (engine Engine) ValidateHeader(blockHeader *types.BlockHeader) error {
 
 // ValidateRewardAmount validates the specified amount and returns error if validation failed.
 func (engine Engine) ValidateRewardAmount(blockHeight uint64, amount *big.Int) error {
-	reward := GetReward(blockHeight)
+	reward := engine.getReward(blockHeight)
 
 	if amount == nil || amount.Cmp(reward) != 0 {
 		return fmt.Errorf("invalid reward amount, block height %d, want %s, got %s", blockHeight, reward, amount)
@@ -49,6 +52,14 @@ func (engine Engine) ValidateRewardAmount(blockHeight uint64, amount *big.Int) e
 	return nil
 }
 
+func (engine Engine) getReward(blockHeight uint64) *big.Int {
+	if engine.Rewards == nil {
+		return GetReward(blockHeight)
+	}
+
+	return engine.Rewards.GetReward(blockHeight)
+}
+
 // GetMiningTarget returns the mining target for the specified difficulty.
 func GetMiningTarget(difficulty *big.Int) *big.Int {
 	return new(big.Int).Div(maxUint256, difficulty)
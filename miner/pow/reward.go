@@ -6,35 +6,91 @@
 package pow
 
 import (
-	"github.com/seeleteam/go-seele/common"
+	"errors"
 	"math/big"
+
+	"github.com/seeleteam/go-seele/common"
 )
 
 var (
 	// rewardTable the reward value is per year. Which means the first value is for first year, second value is for second year, etc...
-	rewardTableCoin []*big.Int
+	rewardTable = []int64{200, 100, 50, 40, 30}
 
 	// tailReward it is used when out of the reward table. we use a constant reward value.
+	tailReward int64 = 30
+
+	// rewardTableCoin is the reward table in coin.
+	rewardTableCoin []*big.Int
+
+	// tailRewardCoin is the tail reward in coin.
 	tailRewardCoin *big.Int
 
 	// blockNumberPerEra block number per reward era. It is approximation of block number generated per year.
 	blockNumberPerEra uint64 = 525000 * 4
 	//SeeleToCoin base coin number
 	SeeleToCoin = common.SeeleToCoin
+
+	errRewardEraBlocksZero = errors.New("blocks per reward era is zero")
+	errRewardNegative      = errors.New("reward is negative")
 )
 
 func init() {
-	rewardTable := [...]int64{200, 100, 50, 40, 30}
-	tailReward := int64(30)
-
 	rewardTableCoin = make([]*big.Int, len(rewardTable))
 	for i, r := range rewardTable {
-		seele := big.NewInt(r)
-		rewardTableCoin[i] = big.NewInt(0).Mul(seele, SeeleToCoin)
+		rewardTableCoin[i] = seeleToCoin(r)
+	}
+
+	tailRewardCoin = seeleToCoin(tailReward)
+}
+
+// RewardSchedule represents the miner reward schedule, in which the reward
+// of each era is specified in seele, and the tail reward is used when out of eras.
+type RewardSchedule struct {
+	BlocksPerEra uint64  `json:"blocksPerEra"`
+	EraRewards   []int64 `json:"eraRewards"`
+	TailReward   int64   `json:"tailReward"`
+}
+
+// DefaultRewardSchedule returns the default miner reward schedule.
+func DefaultRewardSchedule() *RewardSchedule {
+	eraRewards := make([]int64, len(rewardTable))
+	copy(eraRewards, rewardTable)
+
+	return &RewardSchedule{
+		BlocksPerEra: blockNumberPerEra,
+		EraRewards:   eraRewards,
+		TailReward:   tailReward,
+	}
+}
+
+// Validate returns error if the reward schedule is invalid.
+func (schedule *RewardSchedule) Validate() error {
+	if schedule.BlocksPerEra == 0 {
+		return errRewardEraBlocksZero
+	}
+
+	if schedule.TailReward < 0 {
+		return errRewardNegative
+	}
+
+	for _, r := range schedule.EraRewards {
+		if r < 0 {
+			return errRewardNegative
+		}
 	}
 
-	reward := big.NewInt(tailReward)
-	tailRewardCoin = big.NewInt(0).Mul(reward, SeeleToCoin)
+	return nil
+}
+
+// GetReward get reward amount in coin according to block height
+func (schedule *RewardSchedule) GetReward(blockHeight uint64) *big.Int {
+	era := blockHeight / schedule.BlocksPerEra
+
+	if era < uint64(len(schedule.EraRewards)) {
+		return seeleToCoin(schedule.EraRewards[era])
+	}
+
+	return seeleToCoin(schedule.TailReward)
 }
 
 // GetReward get reward amount according to block height
@@ -50,3 +106,7 @@ func GetReward(blockHeight uint64) *big.Int {
 
 	return big.NewInt(0).Set(result)
 }
+
+func seeleToCoin(seele int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(seele), SeeleToCoin)
+}
@@ -22,3 +22,22 @@ func Test_Reward(t *testing.T) {
 
 	assert.Equal(t, GetReward(blockNumberPerEra*uint64(len(rewardTableCoin))), tailRewardCoin, "5")
 }
+
+func Test_RewardSchedule(t *testing.T) {
+	schedule := DefaultRewardSchedule()
+	assert.Equal(t, schedule.Validate(), error(nil))
+
+	for _, height := range []uint64{0, blockNumberPerEra, blockNumberPerEra * 3, blockNumberPerEra * 10} {
+		assert.Equal(t, schedule.GetReward(height), GetReward(height))
+	}
+
+	schedule = &RewardSchedule{BlocksPerEra: 10, EraRewards: []int64{5}, TailReward: 1}
+	assert.Equal(t, schedule.GetReward(9), seeleToCoin(5))
+	assert.Equal(t, schedule.GetReward(10), seeleToCoin(1))
+
+	schedule.BlocksPerEra = 0
+	assert.Equal(t, schedule.Validate(), errRewardEraBlocksZero)
+
+	schedule = &RewardSchedule{BlocksPerEra: 10, EraRewards: []int64{-1}}
+	assert.Equal(t, schedule.Validate(), errRewardNegative)
+}
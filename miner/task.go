@@ -15,7 +15,6 @@ import (
 	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/log"
-	"github.com/seeleteam/go-seele/miner/pow"
 )
 
 // Task is a mining work for engine, containing block header, transactions, and transaction receipts.
@@ -31,7 +30,7 @@ type Task struct {
 func (task *Task) applyTransactions(seele SeeleBackend, statedb *state.Statedb, blockHeight uint64,
 	txs map[common.Address][]*types.Transaction, log *log.SeeleLog) error {
 	// the reward tx will always be at the first of the block's transactions
-	rewardValue := pow.GetReward(blockHeight)
+	rewardValue := seele.BlockChain().ChainConfig().Rewards.GetReward(blockHeight)
 	reward, err := types.NewTransaction(common.Address{}, seele.GetCoinbase(), rewardValue, big.NewInt(0), 0)
 	if err != nil {
 		return err
@@ -67,7 +66,7 @@ func (task *Task) chooseTransactions(seele SeeleBackend, statedb *state.Statedb,
 
 		seele.TxPool().RemoveTransaction(tx.Hash)
 
-		err := tx.Validate(statedb)
+		err := tx.Validate(statedb, seele.BlockChain().ChainConfig().TxRules(task.header.Height))
 		if err != nil {
 			log.Error("validating tx failed, for %s", err.Error())
 			continue
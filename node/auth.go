@@ -0,0 +1,38 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package node
+
+import (
+	"path/filepath"
+
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// JWTSecretFileName is the file name of the secret in the node data folder,
+// which is shared with clients to sign the bearer tokens to access private APIs.
+const JWTSecretFileName = "jwtsecret"
+
+// JWTSecretFile returns the secret file in the specified node data folder.
+func JWTSecretFile(dataDir string) string {
+	return filepath.Join(dataDir, JWTSecretFileName)
+}
+
+// newAuthenticator creates an authenticator that requires authentication for the private APIs.
+func (n *Node) newAuthenticator(apis []rpc.API) (*rpc.Authenticator, error) {
+	secret, err := rpc.LoadOrCreateSecret(JWTSecretFile(n.config.BasicConfig.DataDir))
+	if err != nil {
+		return nil, err
+	}
+
+	auth := rpc.NewAuthenticator(secret)
+	for _, api := range apis {
+		if !api.Public {
+			auth.AddPrivateNamespace(api.Namespace)
+		}
+	}
+
+	return auth, nil
+}
@@ -6,6 +6,8 @@
 package node
 
 import (
+	"path/filepath"
+
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/core"
 	"github.com/seeleteam/go-seele/log/comm"
@@ -14,6 +16,15 @@ import (
 	"github.com/seeleteam/go-seele/rpc"
 )
 
+// NodeKeyFileName is the file name of the encrypted p2p private key in the node
+// data folder, which is the identity of node in p2p network.
+const NodeKeyFileName = "nodekey"
+
+// NodeKeyFile returns the node key file in the specified node data folder.
+func NodeKeyFile(dataDir string) string {
+	return filepath.Join(dataDir, NodeKeyFileName)
+}
+
 // Config is the Configuration of node
 type Config struct {
 	//Config is the Configuration of log
@@ -34,6 +45,9 @@ type Config struct {
 	// The configuration of websocket rpc service
 	WSServerConfig rpc.WSServerConfig
 
+	// The limits of requests on all rpc services
+	RPCLimits rpc.LimiterConfig
+
 	// metrics config info
 	MetricsConfig metrics.Config `json:"metrics"`
 }
@@ -52,8 +66,17 @@ type BasicConfig struct {
 	// RPCAddr is the address on which to start RPC server.
 	RPCAddr string `json:"address"`
 
+	// RPCModules is the API namespaces exposed on the RPC server.
+	// Only public APIs are exposed if empty, and all APIs are
+	// always exposed on the IPC endpoint in DataDir.
+	RPCModules []string `json:"modules"`
+
 	// coinbase used by the miner
 	Coinbase string `json:"coinbase"`
+
+	// ExternalSigner is the TCP address of the external signer daemon, which signs
+	// with the accounts not unlocked in the node keystore. Disabled if empty.
+	ExternalSigner string `json:"externalSigner,omitempty"`
 }
 
 // HTTPServer config for http server
@@ -68,6 +91,14 @@ type HTTPServer struct {
 
 	// HTTPHostFilter is the whitelist of hostnames which are allowed on incoming requests.
 	HTTPWhiteHost []string `json:"whiteHost"`
+
+	// HTTPModules is the API namespaces exposed on the HTTP rpc service.
+	// Only public APIs are exposed if empty.
+	HTTPModules []string `json:"modules"`
+
+	// HTTPAuth indicates whether to authenticate the requests to private APIs
+	// with bearer token signed by the secret in node data folder.
+	HTTPAuth bool `json:"auth"`
 }
 
 // Config is the seele's configuration to create seele service
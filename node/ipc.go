@@ -0,0 +1,97 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package node
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// IPCFileName is the file name of the IPC endpoint (Unix domain socket) in the node data folder.
+const IPCFileName = "seele.ipc"
+
+// ipcDialTimeout is the timeout to check whether the IPC endpoint is in use.
+const ipcDialTimeout = time.Second
+
+var errIPCInUse = errors.New("IPC endpoint is in use by another running node, use another data folder")
+
+// IPCEndpoint returns the IPC endpoint in the specified node data folder.
+func IPCEndpoint(dataDir string) string {
+	return filepath.Join(dataDir, IPCFileName)
+}
+
+// startIPC starts the json rpc server on the IPC endpoint, which is only accessible
+// by the current user on the same host, so all the APIs are exposed by default.
+func (n *Node) startIPC(apis []rpc.API) error {
+	if len(n.config.BasicConfig.DataDir) == 0 {
+		n.log.Debug("IPC endpoint disabled since no data folder specified")
+		return nil
+	}
+
+	handler := rpc.NewServer()
+	for _, api := range apis {
+		if _, ok := api.Service.(rpc.Subscriber); ok {
+			// subscriptions are only available on websocket
+			continue
+		}
+
+		if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
+			n.log.Error("IPC api registration failed, service: %v, namespace: %s", api.Service, api.Namespace)
+			return err
+		}
+	}
+
+	endpoint := IPCEndpoint(n.config.BasicConfig.DataDir)
+	listener, err := listenIPC(endpoint)
+	if err != nil {
+		n.log.Error("IPC listening failed, %s", err)
+		return err
+	}
+
+	n.log.Info("IPC endpoint opened: %s", endpoint)
+
+	rpcListener := newJSONRPCListener(listener, handler, n.rpcLimiter, n.log)
+	rpcListener.serve()
+	n.rpcListeners = append(n.rpcListeners, rpcListener)
+
+	return nil
+}
+
+// listenIPC listens on the Unix domain socket of the specified endpoint,
+// and restricts the access to the current user.
+func listenIPC(endpoint string) (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(endpoint), 0700); err != nil {
+		return nil, err
+	}
+
+	// refuse to take the socket of another running node of the same data folder
+	if conn, err := net.DialTimeout("unix", endpoint, ipcDialTimeout); err == nil {
+		conn.Close()
+		return nil, errIPCInUse
+	}
+
+	// remove the stale socket file if node not stopped normally
+	if err := os.Remove(endpoint); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = os.Chmod(endpoint, 0600); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
@@ -0,0 +1,85 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+type testPrivateAPI struct{}
+
+func (api *testPrivateAPI) Echo(input *string, result *string) error {
+	*result = *input
+	return nil
+}
+
+func Test_IPC(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "seele-ipc-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	conf := testNodeConfig()
+	conf.BasicConfig.DataDir = dataDir
+
+	stack, err := New(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apis := []rpc.API{{Namespace: "test", Service: new(testPrivateAPI), Public: false}}
+	if err = stack.startIPC(apis); err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint := IPCEndpoint(dataDir)
+	info, err := os.Stat(endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, info.Mode().Perm(), os.FileMode(0600))
+
+	client, err := rpc.Dial("unix", endpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input, result := "hello", ""
+	err = client.Call("test.Echo", &input, &result)
+	client.Close()
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, result, "hello")
+
+	// the endpoint of running node is not taken
+	_, err = listenIPC(endpoint)
+	assert.Equal(t, err, errIPCInUse)
+
+	stack.stopRPC()
+	assert.Equal(t, common.FileOrFolderExists(endpoint), false)
+
+	// the stale socket file is replaced
+	ioutil.WriteFile(endpoint, nil, 0600)
+	listener, err := listenIPC(endpoint)
+	assert.Equal(t, err, error(nil))
+	listener.Close()
+}
+
+func Test_IPC_NoDataDir(t *testing.T) {
+	stack, err := New(testNodeConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, stack.startIPC(nil), error(nil))
+	assert.Equal(t, len(stack.rpcListeners), 0)
+}
@@ -6,14 +6,15 @@
 package node
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
 	"net"
 	"net/http"
-	netrpc "net/rpc"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/log"
@@ -31,6 +32,10 @@ var (
 	ErrServiceStopFailed  = errors.New("node service stopping failed")
 )
 
+// rpcShutdownTimeout is the max duration to wait for the active
+// RPC requests to finish when the node is stopped.
+var rpcShutdownTimeout = 5 * time.Second
+
 // StopError represents an error which is returned when a node fails to stop any registered service
 type StopError struct {
 	Services map[reflect.Type]error // Services is a container mapping the type of services which fail to stop to error
@@ -48,7 +53,11 @@ type Node struct {
 	server   *p2p.Server
 	services []Service
 
-	rpcAPIs []rpc.API
+	rpcAPIs      []rpc.API
+	rpcListeners []*jsonRPCListener // json rpc listeners of TCP and IPC
+	httpServers  []*http.Server     // servers of HTTP and websocket rpc
+	wsServer     *rpc.WsRPCServer
+	rpcLimiter   *rpc.Limiter // shared by all rpc services to limit requests per client
 
 	log  *log.SeeleLog
 	lock sync.RWMutex
@@ -61,9 +70,10 @@ func New(conf *Config) (*Node, error) {
 	nlog := log.GetLogger("node", conf.LogConfig.PrintLog)
 
 	return &Node{
-		config:   conf,
-		services: []Service{},
-		log:      nlog,
+		config:     conf,
+		services:   []Service{},
+		rpcLimiter: rpc.NewLimiter(conf.RPCLimits),
+		log:        nlog,
 	}, nil
 }
 
@@ -132,6 +142,8 @@ func (n *Node) Start() error {
 
 	// Start RPC server
 	if err := n.startRPC(n.services, n.config); err != nil {
+		n.stopRPC()
+
 		for _, service := range n.services {
 			service.Stop()
 		}
@@ -154,17 +166,24 @@ func (n *Node) startRPC(services []Service, conf *Config) error {
 		apis = append(apis, service.APIs()...)
 	}
 
-	if err := n.startJSONRPC(apis); err != nil {
+	// Private APIs are only exposed on the IPC endpoint by default.
+	if err := n.startIPC(withMetadataAPI(apis)); err != nil {
+		n.log.Error("starting IPC failed: %s", err)
+		return err
+	}
+
+	if err := n.startJSONRPC(withMetadataAPI(n.exposedAPIs(apis, conf.BasicConfig.RPCModules, true))); err != nil {
 		n.log.Error("starting json rpc failed", err)
 		return err
 	}
 
-	if err := n.startHTTPRPC(apis, conf.HTTPServer.HTTPWhiteHost, conf.HTTPServer.HTTPCors); err != nil {
+	httpAPIs := withMetadataAPI(n.exposedAPIs(apis, conf.HTTPServer.HTTPModules, true))
+	if err := n.startHTTPRPC(httpAPIs, conf.HTTPServer.HTTPWhiteHost, conf.HTTPServer.HTTPCors); err != nil {
 		n.log.Error("starting http rpc failed", err)
 		return err
 	}
 
-	if err := n.startWSRPC(apis); err != nil {
+	if err := n.startWSRPC(withMetadataAPI(n.exposedAPIs(apis, conf.WSServerConfig.WSModules, true))); err != nil {
 		n.log.Error("start websocket err", err)
 		return err
 	}
@@ -172,10 +191,63 @@ func (n *Node) startRPC(services []Service, conf *Config) error {
 	return nil
 }
 
+// exposedAPIs returns the APIs of the specified modules (namespaces). If no module
+// specified, only public APIs are returned when publicOnly is true, otherwise all APIs.
+// The calls to the APIs that are not exposed will be rejected with "method not found" error.
+func (n *Node) exposedAPIs(apis []rpc.API, modules []string, publicOnly bool) []rpc.API {
+	if len(modules) == 0 {
+		if !publicOnly {
+			return apis
+		}
+
+		var result []rpc.API
+		for _, api := range apis {
+			if api.Public {
+				result = append(result, api)
+			}
+		}
+
+		return result
+	}
+
+	namespaces := make(map[string]bool)
+	for _, module := range modules {
+		namespaces[module] = false
+	}
+
+	var result []rpc.API
+	for _, api := range apis {
+		if _, ok := namespaces[api.Namespace]; ok {
+			namespaces[api.Namespace] = true
+			result = append(result, api)
+		}
+	}
+
+	for module, found := range namespaces {
+		if !found && module != rpc.MetadataAPI {
+			n.log.Warn("rpc module %s not found", module)
+		}
+	}
+
+	return result
+}
+
+// withMetadataAPI returns the specified APIs and the metadata API that describes them,
+// so that the clients could discover the APIs exposed on each RPC service.
+func withMetadataAPI(apis []rpc.API) []rpc.API {
+	result := append([]rpc.API{}, apis...)
+	return append(result, rpc.NewRPCService(apis).API())
+}
+
 // startJSONRPC starts the json rpc server
 func (n *Node) startJSONRPC(apis []rpc.API) error {
 	handler := rpc.NewServer()
 	for _, api := range apis {
+		if _, ok := api.Service.(rpc.Subscriber); ok {
+			// subscriptions are only available on websocket
+			continue
+		}
+
 		if err := handler.RegisterName(api.Namespace, api.Service); err != nil {
 			n.log.Error("Api registration failed", "service", api.Service, "namespace", api.Namespace)
 			return err
@@ -194,16 +266,10 @@ func (n *Node) startJSONRPC(apis []rpc.API) error {
 	}
 
 	n.log.Debug("Listerner address %s", listerner.Addr().String())
-	go func() {
-		for {
-			conn, err := listerner.Accept()
-			if err != nil {
-				n.log.Error("RPC accepting failed", "err", err)
-				continue
-			}
-			go handler.ServeCodec(rpc.NewJSONCodec(conn, nil))
-		}
-	}()
+
+	rpcListener := newJSONRPCListener(listerner, handler, n.rpcLimiter, n.log)
+	rpcListener.serve()
+	n.rpcListeners = append(n.rpcListeners, rpcListener)
 
 	return nil
 }
@@ -211,8 +277,24 @@ func (n *Node) startJSONRPC(apis []rpc.API) error {
 // startHTTPRPC starts the http rpc server
 func (n *Node) startHTTPRPC(apis []rpc.API, whitehosts []string, corsList []string) error {
 	httpServer, httpHandler := rpc.NewHTTPServer(whitehosts, corsList)
+	httpServer.SetLimiter(n.rpcLimiter)
+	if n.config.HTTPServer.HTTPAuth {
+		auth, err := n.newAuthenticator(apis)
+		if err != nil {
+			n.log.Error("HTTP authenticator creation failed, %s", err)
+			return err
+		}
+
+		httpServer.SetAuthenticator(auth)
+	}
+
 	rpcServer := httpServer.GetRPCServer()
 	for _, api := range apis {
+		if _, ok := api.Service.(rpc.Subscriber); ok {
+			// subscriptions are only available on websocket
+			continue
+		}
+
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
 			n.log.Error("Api registration failed", "service", api.Service, "namespace", api.Namespace)
 			return err
@@ -224,33 +306,102 @@ func (n *Node) startHTTPRPC(apis []rpc.API, whitehosts []string, corsList []stri
 		listerner net.Listener
 		err       error
 	)
-	rpcServer.HandleHTTP(netrpc.DefaultRPCPath, netrpc.DefaultDebugPath)
 	if listerner, err = net.Listen("tcp", n.config.HTTPServer.HTTPAddr); err != nil {
 		n.log.Error("HTTP listening failed", "err", err)
 		return err
 	}
 
-	go http.Serve(listerner, httpHandler)
+	n.serveHTTP(listerner, httpHandler)
 
 	return nil
 }
 
+// serveHTTP starts a goroutine to serve HTTP requests on the listener until the server shutdown.
+func (n *Node) serveHTTP(listener net.Listener, handler http.Handler) {
+	server := &http.Server{Handler: handler}
+	n.httpServers = append(n.httpServers, server)
+
+	go func() {
+		if err := server.Serve(listener); err != http.ErrServerClosed {
+			n.log.Error("HTTP serving on %s failed, %s", listener.Addr(), err)
+		}
+	}()
+}
+
 // startWSRPC starts websocket rpc server
 func (n *Node) startWSRPC(apis []rpc.API) error {
 	handler := rpc.NewWsRPCServer()
+	handler.SetLimiter(n.rpcLimiter)
+	if n.config.WSServerConfig.WSAuth {
+		auth, err := n.newAuthenticator(apis)
+		if err != nil {
+			n.log.Error("Websocket authenticator creation failed, %s", err)
+			return err
+		}
+
+		handler.SetAuthenticator(auth)
+	}
+
 	rpcServer := handler.GetWsRPCServer()
 	for _, api := range apis {
+		if subscriber, ok := api.Service.(rpc.Subscriber); ok {
+			if err := handler.RegisterSubscriber(api.Namespace, subscriber); err != nil {
+				n.log.Error("Websocket subscriber registration failed", "service", api.Service, "namespace", api.Namespace)
+				return err
+			}
+
+			continue
+		}
+
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
 			n.log.Error("Websocket registration failed", "service", api.Service, "namespace", api.Namespace)
 			return err
 		}
 	}
-	http.HandleFunc(n.config.WSServerConfig.WSPattern, handler.ServeWS)
-	go http.ListenAndServe(n.config.WSServerConfig.WSAddr, nil)
+
+	listener, err := net.Listen("tcp", n.config.WSServerConfig.WSAddr)
+	if err != nil {
+		n.log.Error("Websocket listening failed, %s", err)
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(n.config.WSServerConfig.WSPattern, handler.ServeWS)
+
+	n.wsServer = handler
+	n.serveHTTP(listener, mux)
 
 	return nil
 }
 
+// stopRPC stops all the RPC servers, and waits for the active requests to finish
+// until timeout, then closes the remaining connections.
+func (n *Node) stopRPC() {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcShutdownTimeout)
+	defer cancel()
+
+	// websocket connections are hijacked and not closed by HTTP server shutdown
+	if n.wsServer != nil {
+		n.wsServer.Close()
+		n.wsServer = nil
+	}
+
+	for _, server := range n.httpServers {
+		if err := server.Shutdown(ctx); err != nil {
+			n.log.Warn("HTTP server shutdown timeout, %s", err)
+			server.Close()
+		}
+	}
+
+	// unix socket file of IPC endpoint is removed when listener closed
+	for _, listener := range n.rpcListeners {
+		listener.close(ctx)
+	}
+
+	n.httpServers = nil
+	n.rpcListeners = nil
+}
+
 // Stop terminates the running node and services registered.
 func (n *Node) Stop() error {
 	n.lock.Lock()
@@ -265,6 +416,9 @@ func (n *Node) Stop() error {
 		Services: make(map[reflect.Type]error),
 	}
 
+	// stop RPC at first to reject new requests to the services
+	n.stopRPC()
+
 	for _, service := range n.services {
 		if err := service.Stop(); err != nil {
 			stopErr.Services[reflect.TypeOf(service)] = err
@@ -274,7 +428,7 @@ func (n *Node) Stop() error {
 	// stop the p2p server
 	n.server.Stop()
 
-	n.services = nil
+	// keep the registered services so that the node could be restarted
 	n.server = nil
 
 	// return the stop errors if any
@@ -6,8 +6,17 @@
 package node
 
 import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/log/comm"
 	"github.com/seeleteam/go-seele/p2p"
@@ -80,3 +89,145 @@ func Test_ServiceRegistry(t *testing.T) {
 		t.Fatalf("failed to stop service stack: %v", err)
 	}
 }
+
+func Test_ExposedAPIs(t *testing.T) {
+	stack, err := New(testNodeConfig())
+	if err != nil {
+		t.Fatalf("failed to create node: %v", err)
+	}
+
+	apis := []rpc.API{
+		{Namespace: "seele", Public: true},
+		{Namespace: "miner", Public: false},
+		{Namespace: "debug", Public: false},
+	}
+
+	namespaces := func(apis []rpc.API) []string {
+		var result []string
+		for _, api := range apis {
+			result = append(result, api.Namespace)
+		}
+		return result
+	}
+
+	assert.Equal(t, namespaces(stack.exposedAPIs(apis, nil, false)), []string{"seele", "miner", "debug"})
+	assert.Equal(t, namespaces(stack.exposedAPIs(apis, nil, true)), []string{"seele"})
+	assert.Equal(t, namespaces(stack.exposedAPIs(apis, []string{"miner", "unknown"}, true)), []string{"miner"})
+	assert.Equal(t, namespaces(stack.exposedAPIs(apis, []string{"seele", "debug"}, false)), []string{"seele", "debug"})
+}
+
+// TestServiceD is a test implementation of the Service interface with RPC APIs.
+type TestServiceD struct{}
+
+func (s TestServiceD) Protocols() []p2p.Protocol { return nil }
+func (s TestServiceD) Start(*p2p.Server) error   { return nil }
+func (s TestServiceD) Stop() error               { return nil }
+func (s TestServiceD) APIs() []rpc.API {
+	return []rpc.API{{Namespace: "test", Service: new(testPrivateAPI), Public: true}}
+}
+
+func freeTestAddr(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
+
+func testRPCCall(t *testing.T, client *rpc.Client) {
+	input, result := "hello", ""
+	assert.Equal(t, client.Call("test.Echo", &input, &result), error(nil))
+	assert.Equal(t, result, "hello")
+}
+
+func Test_Restart(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "seele-node-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	defer func(timeout time.Duration) { rpcShutdownTimeout = timeout }(rpcShutdownTimeout)
+	rpcShutdownTimeout = 100 * time.Millisecond
+
+	conf := testNodeConfig()
+	conf.BasicConfig.DataDir = dataDir
+	conf.BasicConfig.RPCAddr = freeTestAddr(t)
+	conf.HTTPServer.HTTPAddr = freeTestAddr(t)
+	conf.WSServerConfig.WSAddr = freeTestAddr(t)
+
+	stack, err := New(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = stack.Register(TestServiceD{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = stack.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		// TCP
+		tcpClient, err := rpc.Dial("tcp", conf.BasicConfig.RPCAddr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testRPCCall(t, tcpClient)
+
+		// IPC
+		ipcClient, err := rpc.Dial("unix", IPCEndpoint(dataDir))
+		if err != nil {
+			t.Fatal(err)
+		}
+		testRPCCall(t, ipcClient)
+		ipcClient.Close()
+
+		// HTTP
+		body := `{"jsonrpc":"2.0","id":1,"method":"test.Echo","params":["hello"]}`
+		resp, err := http.Post("http://"+conf.HTTPServer.HTTPAddr, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		assert.Equal(t, resp.StatusCode, http.StatusOK)
+
+		// websocket
+		ws, _, err := websocket.DefaultDialer.Dial("ws://"+conf.WSServerConfig.WSAddr+conf.WSServerConfig.WSPattern, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wsClient := rpc.NewClient(&rpc.WebsocketServerConn{Ws: ws})
+		testRPCCall(t, wsClient)
+
+		// the idle TCP and websocket connections are closed when restarted
+		if err = stack.Restart(); err != nil {
+			t.Fatal(err)
+		}
+
+		input, result := "hello", ""
+		assert.Equal(t, tcpClient.Call("test.Echo", &input, &result) != nil, true)
+		assert.Equal(t, wsClient.Call("test.Echo", &input, &result) != nil, true)
+		tcpClient.Close()
+		wsClient.Close()
+	}
+
+	if err = stack.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	// all ports are released
+	for _, addr := range []string{conf.BasicConfig.RPCAddr, conf.HTTPServer.HTTPAddr, conf.WSServerConfig.WSAddr} {
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		listener.Close()
+	}
+
+	assert.Equal(t, common.FileOrFolderExists(IPCEndpoint(dataDir)), false)
+}
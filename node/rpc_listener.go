@@ -0,0 +1,97 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package node
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/seeleteam/go-seele/log"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// jsonRPCListener serves the json rpc over the connections accepted by the listener,
+// and tracks the active connections so that they could be closed when node stopped.
+type jsonRPCListener struct {
+	listener net.Listener
+	handler  *rpc.Server
+	limiter  *rpc.Limiter
+	log      *log.SeeleLog
+
+	lock  sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup // waits for the accepting loop and active connections
+}
+
+func newJSONRPCListener(listener net.Listener, handler *rpc.Server, limiter *rpc.Limiter, log *log.SeeleLog) *jsonRPCListener {
+	return &jsonRPCListener{
+		listener: listener,
+		handler:  handler,
+		limiter:  limiter,
+		log:      log,
+		conns:    make(map[net.Conn]struct{}),
+	}
+}
+
+// serve starts a goroutine to accept connections until the listener closed.
+func (l *jsonRPCListener) serve() {
+	l.wg.Add(1)
+
+	go func() {
+		defer l.wg.Done()
+
+		for {
+			conn, err := l.listener.Accept()
+			if err != nil {
+				l.log.Debug("RPC accepting stopped on %s, %s", l.listener.Addr(), err)
+				return
+			}
+
+			l.lock.Lock()
+			l.conns[conn] = struct{}{}
+			l.wg.Add(1)
+			l.lock.Unlock()
+
+			go func() {
+				defer l.wg.Done()
+
+				// ServeCodec closes the connection when returned.
+				l.handler.ServeCodec(rpc.NewLimitedJSONCodec(conn, &l.handler.Server, l.limiter))
+
+				l.lock.Lock()
+				delete(l.conns, conn)
+				l.lock.Unlock()
+			}()
+		}
+	}()
+}
+
+// close stops accepting connections, and waits for the active connections
+// to finish until the context done, then closes the remaining connections.
+func (l *jsonRPCListener) close(ctx context.Context) {
+	l.listener.Close()
+
+	done := make(chan struct{})
+	go func() {
+		l.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return
+	case <-ctx.Done():
+	}
+
+	l.lock.Lock()
+	for conn := range l.conns {
+		conn.Close()
+	}
+	l.lock.Unlock()
+
+	<-done
+}
@@ -7,10 +7,19 @@ package qvic
 
 import (
 	"sync"
+	"time"
 
 	"github.com/aristanetworks/goarista/monotime"
 )
 
+// Clock returns the current time in milliseconds.
+type Clock func() uint64
+
+// MonotonicClock returns the monotonic time in milliseconds.
+func MonotonicClock() uint64 {
+	return monotime.Now() / uint64(time.Millisecond)
+}
+
 // TokenBucket for bucket limit rate
 type TokenBucket struct {
 	bytesPerSecond int64 // bandwidth
@@ -18,15 +27,22 @@ type TokenBucket struct {
 	curTokens      int64
 	tokensPerMS    int64  // tokens produced every milliseconds
 	reservedTokens int64  // reserved tokens
-	preTick        uint64 // last tick producing tokens
+	preTick        uint64 // last tick producing tokens, in milliseconds
+	clock          Clock
 	mutex          sync.Mutex
 }
 
 // Init initializes TokenBucket with bytesPerSecond and factor
 func (t *TokenBucket) Init(bytesPerSecond int64) {
+	t.InitWithClock(bytesPerSecond, MonotonicClock)
+}
+
+// InitWithClock initializes TokenBucket with bytesPerSecond, and the tokens are produced with the specified clock
+func (t *TokenBucket) InitWithClock(bytesPerSecond int64, clock Clock) {
 	t.initCommon(bytesPerSecond)
 	t.curTokens = t.maxTokens
-	t.preTick = monotime.Now() / 1000
+	t.clock = clock
+	t.preTick = clock()
 }
 
 // AdjustBW adjusts bandwidth anytime
@@ -42,7 +58,7 @@ func (t *TokenBucket) PeriodicFeed() {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	cur := monotime.Now() / 1000
+	cur := t.clock()
 	i := t.curTokens + int64(cur-t.preTick)*t.tokensPerMS
 	if i > t.maxTokens {
 		t.curTokens = t.maxTokens
@@ -65,3 +65,26 @@ func Test_TokenBucketConsumeAndPeriodicFeed(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_TokenBucketFeedWithClock(t *testing.T) {
+	var now uint64 = 1000
+	v1 := new(TokenBucket)
+	v1.InitWithClock(1000, func() uint64 { return now })
+	v1.Consume(1000)
+
+	// 1 token every millisecond
+	now += 500
+	v1.PeriodicFeed()
+	if v1.curTokens != 500 {
+		fmt.Println("FeedWithClock feed:", v1.maxTokens, v1.curTokens)
+		t.Fail()
+	}
+
+	// Feed it with maxTokens
+	now += 2000
+	v1.PeriodicFeed()
+	if v1.curTokens != v1.maxTokens {
+		fmt.Println("FeedWithClock feed with maxTokens:", v1.maxTokens, v1.curTokens)
+		t.Fail()
+	}
+}
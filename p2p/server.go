@@ -66,6 +66,9 @@ type Config struct {
 	// SubPrivateKey which will be make PrivateKey
 	SubPrivateKey string `json:"privateKey"`
 
+	// KeyFile is the encrypted keystore file of PrivateKey, used if SubPrivateKey is empty
+	KeyFile string `json:"keyFile,omitempty"`
+
 	// PrivateKey private key for p2p module, do not use it as any accounts
 	PrivateKey *ecdsa.PrivateKey
 }
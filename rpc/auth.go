@@ -0,0 +1,224 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// tokenMaxAge is the max duration between the token issued time and now.
+	tokenMaxAge = 60 * time.Second
+
+	// secretLength is the byte length of the generated secret.
+	secretLength = 32
+
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var (
+	errTokenMissing   = errors.New("bearer token missing")
+	errTokenMalformed = errors.New("malformed token")
+	errTokenAlgorithm = errors.New("unsupported token algorithm")
+	errTokenSignature = errors.New("invalid token signature")
+	errTokenExpired   = errors.New("token expired")
+
+	errSecretInvalid = errors.New("invalid secret")
+
+	errUnauthorized = NewError(-32002, "Unauthorized")
+
+	// tokenHeader is the base64 encoded JWT header of HMAC-SHA256 algorithm.
+	tokenHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+)
+
+// tokenClaims represents the claims in the JWT payload.
+type tokenClaims struct {
+	IssuedAt int64 `json:"iat"`
+}
+
+// Authenticator authenticates the requests to private API namespaces with bearer token,
+// which is a JWT signed with HMAC-SHA256 by the shared secret.
+type Authenticator struct {
+	secret     []byte
+	namespaces map[string]struct{} // private namespaces that require authentication
+}
+
+// NewAuthenticator returns a new Authenticator with the specified shared secret.
+func NewAuthenticator(secret []byte) *Authenticator {
+	return &Authenticator{
+		secret:     secret,
+		namespaces: make(map[string]struct{}),
+	}
+}
+
+// AddPrivateNamespace requires authentication for the methods of the specified namespace.
+func (auth *Authenticator) AddPrivateNamespace(namespace string) {
+	auth.namespaces[namespace] = struct{}{}
+}
+
+// Authenticate verifies the bearer token in the Authorization header.
+func (auth *Authenticator) Authenticate(header http.Header) error {
+	value := header.Get(authorizationHeader)
+	if !strings.HasPrefix(value, bearerPrefix) {
+		return errTokenMissing
+	}
+
+	return VerifyToken(auth.secret, strings.TrimPrefix(value, bearerPrefix), time.Now())
+}
+
+// authorizer returns the function to authorize the request methods with the specified header.
+// The methods of private namespaces are rejected if the header is not authenticated.
+func (auth *Authenticator) authorizer(header http.Header) func(method string) error {
+	authenticated := auth.Authenticate(header) == nil
+
+	return func(method string) error {
+		if authenticated {
+			return nil
+		}
+
+		dot := strings.LastIndex(method, ".")
+		if dot < 0 {
+			return nil
+		}
+
+		if _, ok := auth.namespaces[method[:dot]]; ok {
+			return errUnauthorized
+		}
+
+		return nil
+	}
+}
+
+// NewToken returns a new JWT signed with HMAC-SHA256 by the specified secret.
+func NewToken(secret []byte, now time.Time) (string, error) {
+	claims, err := json.Marshal(tokenClaims{now.Unix()})
+	if err != nil {
+		return "", err
+	}
+
+	content := tokenHeader + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	return content + "." + signToken(secret, content), nil
+}
+
+// VerifyToken verifies the signature and issued time of the specified JWT.
+func VerifyToken(secret []byte, token string, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return errTokenMalformed
+	}
+
+	headerBytes, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return errTokenMalformed
+	}
+
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+
+	if err = json.Unmarshal(headerBytes, &header); err != nil {
+		return errTokenMalformed
+	}
+
+	if header.Algorithm != "HS256" {
+		return errTokenAlgorithm
+	}
+
+	expected := signToken(secret, parts[0]+"."+parts[1])
+	if !hmac.Equal([]byte(parts[2]), []byte(expected)) {
+		return errTokenSignature
+	}
+
+	claimsBytes, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return errTokenMalformed
+	}
+
+	var claims tokenClaims
+	if err = json.Unmarshal(claimsBytes, &claims); err != nil {
+		return errTokenMalformed
+	}
+
+	issuedAt := time.Unix(claims.IssuedAt, 0)
+	if now.Sub(issuedAt) > tokenMaxAge || issuedAt.Sub(now) > tokenMaxAge {
+		return errTokenExpired
+	}
+
+	return nil
+}
+
+func signToken(secret []byte, content string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(content))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// NewAuthHeader returns the HTTP header with bearer token signed by the specified secret.
+func NewAuthHeader(secret []byte) (http.Header, error) {
+	token, err := NewToken(secret, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	header := make(http.Header)
+	header.Set(authorizationHeader, bearerPrefix+token)
+
+	return header, nil
+}
+
+// ReadSecret reads the HEX encoded secret from the specified file.
+func ReadSecret(file string) ([]byte, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil || len(secret) == 0 {
+		return nil, errSecretInvalid
+	}
+
+	return secret, nil
+}
+
+// LoadOrCreateSecret reads the secret from the specified file, or generates
+// a random secret and saves to the file that is only accessible by the current user.
+func LoadOrCreateSecret(file string) ([]byte, error) {
+	if _, err := os.Stat(file); err == nil {
+		return ReadSecret(file)
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return nil, err
+	}
+
+	if err := ioutil.WriteFile(file, []byte(hex.EncodeToString(secret)), 0600); err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
@@ -0,0 +1,183 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+var testSecret = []byte("test secret")
+
+func Test_Token(t *testing.T) {
+	now := time.Now()
+
+	token, err := NewToken(testSecret, now)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, VerifyToken(testSecret, token, now), error(nil))
+	assert.Equal(t, VerifyToken(testSecret, token, now.Add(tokenMaxAge/2)), error(nil))
+
+	// expired or issued in future
+	assert.Equal(t, VerifyToken(testSecret, token, now.Add(2*tokenMaxAge)), errTokenExpired)
+	assert.Equal(t, VerifyToken(testSecret, token, now.Add(-2*tokenMaxAge)), errTokenExpired)
+
+	// invalid secret
+	assert.Equal(t, VerifyToken([]byte("other secret"), token, now), errTokenSignature)
+
+	// tampered claims
+	parts := strings.Split(token, ".")
+	otherToken, _ := NewToken(testSecret, now.Add(time.Hour))
+	tampered := parts[0] + "." + strings.Split(otherToken, ".")[1] + "." + parts[2]
+	assert.Equal(t, VerifyToken(testSecret, tampered, now), errTokenSignature)
+
+	// malformed
+	assert.Equal(t, VerifyToken(testSecret, "abc", now), errTokenMalformed)
+}
+
+type testAuthAPI struct{}
+
+func (api *testAuthAPI) Echo(input *string, result *string) error {
+	*result = *input
+	return nil
+}
+
+func newTestAuthServer(t *testing.T) *HTTPServer {
+	server, _ := NewHTTPServer(nil, nil)
+	if err := server.GetRPCServer().RegisterName("public", new(testAuthAPI)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.GetRPCServer().RegisterName("private", new(testAuthAPI)); err != nil {
+		t.Fatal(err)
+	}
+
+	auth := NewAuthenticator(testSecret)
+	auth.AddPrivateNamespace("private")
+	server.SetAuthenticator(auth)
+
+	return server
+}
+
+func postTestRequest(server *HTTPServer, body string, header http.Header) []byte {
+	req := httptest.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(body))
+	req.Header.Set("content-type", "application/json")
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, req)
+
+	return w.Body.Bytes()
+}
+
+type testAuthResponse struct {
+	Result string `json:"result"`
+	Error  *Error `json:"error"`
+}
+
+func Test_HTTPServer_Auth(t *testing.T) {
+	server := newTestAuthServer(t)
+
+	header, err := NewAuthHeader(testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalidHeader, err := NewAuthHeader([]byte("other secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		method     string
+		header     http.Header
+		authorized bool
+	}{
+		{"public.Echo", nil, true},
+		{"public.Echo", header, true},
+		{"private.Echo", nil, false},
+		{"private.Echo", invalidHeader, false},
+		{"private.Echo", header, true},
+	}
+
+	for _, c := range cases {
+		body := `{"jsonrpc":"2.0","id":1,"method":"` + c.method + `","params":["hello"]}`
+
+		var resp testAuthResponse
+		if err := json.Unmarshal(postTestRequest(server, body, c.header), &resp); err != nil {
+			t.Fatal(err)
+		}
+
+		if c.authorized {
+			assert.Equal(t, resp.Error == nil, true)
+			assert.Equal(t, resp.Result, "hello")
+		} else {
+			assert.Equal(t, resp.Error.Code, errUnauthorized.Code)
+		}
+	}
+}
+
+func Test_HTTPServer_AuthBatch(t *testing.T) {
+	server := newTestAuthServer(t)
+
+	body := `[{"jsonrpc":"2.0","id":1,"method":"public.Echo","params":["hello"]},` +
+		`{"jsonrpc":"2.0","id":2,"method":"private.Echo","params":["hello"]}]`
+
+	var resps []struct {
+		ID int `json:"id"`
+		testAuthResponse
+	}
+
+	if err := json.Unmarshal(postTestRequest(server, body, nil), &resps); err != nil {
+		t.Fatal(err)
+	}
+
+	// the responses of batch requests are in any order
+	assert.Equal(t, len(resps), 2)
+	for _, resp := range resps {
+		if resp.ID == 1 {
+			assert.Equal(t, resp.Result, "hello")
+		} else {
+			assert.Equal(t, resp.Error.Code, errUnauthorized.Code)
+		}
+	}
+}
+
+func Test_LoadOrCreateSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "seele-secret-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "secret")
+
+	secret, err := LoadOrCreateSecret(file)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, len(secret), secretLength)
+
+	info, err := os.Stat(file)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, info.Mode().Perm(), os.FileMode(0600))
+
+	loaded, err := LoadOrCreateSecret(file)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, loaded, secret)
+
+	loaded, err = ReadSecret(file)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, loaded, secret)
+}
@@ -10,22 +10,33 @@ import (
 	"net/rpc"
 )
 
-var jErrRequest = json.RawMessage(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}}`)
+var jErrRequest = json.RawMessage(`{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Invalid request"}}`)
+
+const (
+	batchMethod        = "JSONRPC2.Batch"
+	unauthorizedMethod = "JSONRPC2.Unauthorized"
+	throttledMethod    = "JSONRPC2.Throttled"
+)
 
 // JSONRPC2 is an internal RPC service used to process batch requests.
 type JSONRPC2 struct{}
 
 // BatchArg is a param for internal RPC JSONRPC2.Batch.
 type BatchArg struct {
-	srv  *rpc.Server
-	reqs []*json.RawMessage
+	srv       *rpc.Server
+	reqs      []*json.RawMessage
+	authorize func(method string) error
+	throttle  func(method string) *Error
 }
 
 // Batch is an internal RPC method used to process batch requests.
 func (JSONRPC2) Batch(arg BatchArg, replies *[]*json.RawMessage) (err error) {
 	cli, srv := net.Pipe()
 	defer cli.Close()
-	go arg.srv.ServeCodec(NewJSONCodec(srv, arg.srv))
+	codec := newJSONCodec(srv, arg.srv)
+	codec.authorize = arg.authorize
+	codec.throttle = arg.throttle
+	go arg.srv.ServeCodec(codec)
 
 	replyc := make(chan *json.RawMessage, len(arg.reqs))
 	donec := make(chan struct{}, 1)
@@ -64,3 +75,13 @@ func (JSONRPC2) Batch(arg BatchArg, replies *[]*json.RawMessage) (err error) {
 	<-donec
 	return
 }
+
+// Unauthorized is an internal RPC method used to reject the unauthorized requests.
+func (JSONRPC2) Unauthorized(arg *json.RawMessage, reply *json.RawMessage) error {
+	return errUnauthorized
+}
+
+// Throttled is an internal RPC method used to reject the throttled requests.
+func (JSONRPC2) Throttled(arg *Error, reply *json.RawMessage) error {
+	return arg
+}
@@ -120,8 +120,8 @@ func (r *clientResponse) reset() {
 
 func (r *clientResponse) UnmarshalJSON(raw []byte) error {
 	r.reset()
-	type resp *clientResponse
-	if err := json.Unmarshal(raw, resp(r)); err != nil {
+	type resp clientResponse
+	if err := json.Unmarshal(raw, (*resp)(r)); err != nil {
 		return errors.New("bad response: " + string(raw))
 	}
 
@@ -0,0 +1,274 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"encoding"
+	"errors"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// metadataVersion is the version of the metadata API.
+const metadataVersion = "1.0"
+
+var (
+	errModuleNotFound = errors.New("module not found")
+
+	typeOfError         = reflect.TypeOf((*error)(nil)).Elem()
+	typeOfBigInt        = reflect.TypeOf(big.Int{})
+	typeOfTextMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// RPCService offers the meta information of the APIs served by the RPC server,
+// so that clients could discover the namespaces and methods dynamically.
+type RPCService struct {
+	apis []API
+}
+
+// ModuleDescription describes the methods of an API namespace.
+type ModuleDescription struct {
+	Namespace string               `json:"namespace"`
+	Version   string               `json:"version"`
+	Public    bool                 `json:"public"` // false if any service in the namespace is private
+	Methods   []*MethodDescription `json:"methods"`
+}
+
+// MethodDescription describes the param and result of an API method.
+type MethodDescription struct {
+	Name   string      `json:"name"`
+	Params *TypeSchema `json:"params"`
+	Result *TypeSchema `json:"result"`
+}
+
+// TypeSchema describes the JSON shape of a Go type.
+type TypeSchema struct {
+	Type   string         `json:"type"`             // JSON type, e.g. string, integer, number, boolean, array, object or any
+	GoType string         `json:"goType,omitempty"` // Go type name if named, e.g. common.Address
+	Items  *TypeSchema    `json:"items,omitempty"`  // schema of array elements
+	Values *TypeSchema    `json:"values,omitempty"` // schema of map values
+	Fields []*FieldSchema `json:"fields,omitempty"` // schemas of struct fields
+}
+
+// FieldSchema describes a field of JSON object.
+type FieldSchema struct {
+	Name   string      `json:"name"`
+	Schema *TypeSchema `json:"schema"`
+}
+
+// NewRPCService creates a service to describe the specified APIs and itself.
+func NewRPCService(apis []API) *RPCService {
+	service := &RPCService{}
+	service.apis = append(append([]API{}, apis...), service.API())
+	return service
+}
+
+// API returns the API of the service, which should be registered with other APIs.
+func (s *RPCService) API() API {
+	return API{
+		Namespace: MetadataAPI,
+		Version:   metadataVersion,
+		Service:   s,
+		Public:    true,
+	}
+}
+
+// Modules returns the served API namespaces and their versions.
+func (s *RPCService) Modules(input interface{}, result *map[string]string) error {
+	modules := make(map[string]string)
+	for _, api := range s.apis {
+		modules[api.Namespace] = api.Version
+	}
+
+	*result = modules
+	return nil
+}
+
+// Describe returns the descriptions of the specified API namespace, and all namespaces if empty.
+func (s *RPCService) Describe(namespace *string, result *[]*ModuleDescription) error {
+	modules := make(map[string]*ModuleDescription)
+	var names []string
+
+	for _, api := range s.apis {
+		if namespace != nil && *namespace != "" && api.Namespace != *namespace {
+			continue
+		}
+
+		module, ok := modules[api.Namespace]
+		if !ok {
+			module = &ModuleDescription{
+				Namespace: api.Namespace,
+				Version:   api.Version,
+				Public:    true,
+			}
+
+			modules[api.Namespace] = module
+			names = append(names, api.Namespace)
+		}
+
+		module.Public = module.Public && api.Public
+		module.Methods = append(module.Methods, describeMethods(api.Service)...)
+	}
+
+	if len(names) == 0 {
+		return errModuleNotFound
+	}
+
+	sort.Strings(names)
+
+	descriptions := make([]*ModuleDescription, len(names))
+	for i, name := range names {
+		descriptions[i] = modules[name]
+		sort.Slice(descriptions[i].Methods, func(a, b int) bool {
+			return descriptions[i].Methods[a].Name < descriptions[i].Methods[b].Name
+		})
+	}
+
+	*result = descriptions
+	return nil
+}
+
+// describeMethods returns the descriptions of the service methods that suit for RPC,
+// which is the same as net/rpc, e.g. func (t *T) MethodName(argType T1, replyType *T2) error.
+func describeMethods(service interface{}) []*MethodDescription {
+	serviceType := reflect.TypeOf(service)
+
+	var methods []*MethodDescription
+	for i := 0; i < serviceType.NumMethod(); i++ {
+		method := serviceType.Method(i)
+		mtype := method.Type
+
+		if method.PkgPath != "" || mtype.NumIn() != 3 || mtype.NumOut() != 1 || mtype.Out(0) != typeOfError {
+			continue
+		}
+
+		argType, replyType := mtype.In(1), mtype.In(2)
+		if replyType.Kind() != reflect.Ptr || !isExportedOrBuiltinType(argType) || !isExportedOrBuiltinType(replyType) {
+			continue
+		}
+
+		methods = append(methods, &MethodDescription{
+			Name:   method.Name,
+			Params: newTypeSchema(argType, make(map[reflect.Type]bool)),
+			Result: newTypeSchema(replyType, make(map[reflect.Type]bool)),
+		})
+	}
+
+	return methods
+}
+
+func isExportedOrBuiltinType(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.PkgPath() == "" {
+		return true
+	}
+
+	r, _ := utf8.DecodeRuneInString(t.Name())
+	return unicode.IsUpper(r)
+}
+
+// newTypeSchema returns the JSON schema of the specified type. The visiting
+// types are used to stop the recursion of self-referencing types.
+func newTypeSchema(t reflect.Type, visiting map[reflect.Type]bool) *TypeSchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	schema := &TypeSchema{}
+	if t.Name() != "" {
+		schema.GoType = t.String()
+	}
+
+	switch {
+	case t == typeOfBigInt:
+		schema.Type = "integer"
+		return schema
+	case t.Implements(typeOfTextMarshaler) || reflect.PtrTo(t).Implements(typeOfTextMarshaler):
+		schema.Type = "string"
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		schema.Type = "string"
+	case reflect.Bool:
+		schema.Type = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema.Type = "integer"
+	case reflect.Float32, reflect.Float64:
+		schema.Type = "number"
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			// []byte is encoded as base64 string
+			schema.Type = "string"
+		} else {
+			schema.Type = "array"
+			schema.Items = newTypeSchema(t.Elem(), visiting)
+		}
+	case reflect.Map:
+		schema.Type = "object"
+		schema.Values = newTypeSchema(t.Elem(), visiting)
+	case reflect.Struct:
+		schema.Type = "object"
+		if !visiting[t] {
+			visiting[t] = true
+			schema.Fields = newFieldSchemas(t, visiting)
+			delete(visiting, t)
+		}
+	default:
+		schema.Type = "any"
+	}
+
+	return schema
+}
+
+// newFieldSchemas returns the schemas of the struct fields encoded in JSON,
+// and the fields of embedded struct are promoted.
+func newFieldSchemas(t reflect.Type, visiting map[reflect.Type]bool) []*FieldSchema {
+	var fields []*FieldSchema
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+
+			if tagName := strings.Split(tag, ",")[0]; tagName != "" {
+				name = tagName
+			}
+		}
+
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if field.Anonymous && name == field.Name && fieldType.Kind() == reflect.Struct {
+			fields = append(fields, newFieldSchemas(fieldType, visiting)...)
+			continue
+		}
+
+		if field.PkgPath != "" {
+			// unexported
+			continue
+		}
+
+		fields = append(fields, &FieldSchema{name, newTypeSchema(field.Type, visiting)})
+	}
+
+	return fields
+}
@@ -0,0 +1,90 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+)
+
+type testDescribeFilter struct {
+	Addresses []common.Address
+}
+
+type DescribeTestRequest struct {
+	Height int64 `json:"height"`
+	Hidden bool  `json:"-"`
+	hidden bool
+	testDescribeFilter
+	Next *DescribeTestRequest
+}
+
+type testDescribeAPI struct{}
+
+func (api *testDescribeAPI) GetBalance(account *common.Address, result *big.Int) error {
+	return nil
+}
+
+func (api *testDescribeAPI) GetBlocks(request *DescribeTestRequest, result *[]map[string]interface{}) error {
+	return nil
+}
+
+func (api *testDescribeAPI) NotRPC(a, b int) {}
+
+func Test_RPCService_Describe(t *testing.T) {
+	service := NewRPCService([]API{
+		{Namespace: "test", Version: "1.0", Service: &testDescribeAPI{}, Public: true},
+		{Namespace: "debug", Version: "2.0", Service: &WSTest{}, Public: false},
+	})
+
+	var modules map[string]string
+	assert.Equal(t, service.Modules(nil, &modules), nil)
+	assert.Equal(t, modules, map[string]string{"test": "1.0", "debug": "2.0", MetadataAPI: metadataVersion})
+
+	var descriptions []*ModuleDescription
+	namespace := "test"
+	assert.Equal(t, service.Describe(&namespace, &descriptions), nil)
+	assert.Equal(t, len(descriptions), 1)
+
+	module := descriptions[0]
+	assert.Equal(t, module.Namespace, "test")
+	assert.Equal(t, module.Public, true)
+	assert.Equal(t, len(module.Methods), 2)
+
+	balance := module.Methods[0]
+	assert.Equal(t, balance.Name, "GetBalance")
+	assert.Equal(t, balance.Params, &TypeSchema{Type: "string", GoType: "common.Address"})
+	assert.Equal(t, balance.Result, &TypeSchema{Type: "integer", GoType: "big.Int"})
+
+	blocks := module.Methods[1]
+	assert.Equal(t, blocks.Name, "GetBlocks")
+	assert.Equal(t, blocks.Result, &TypeSchema{Type: "array", Items: &TypeSchema{Type: "object", Values: &TypeSchema{Type: "any"}}})
+
+	// json tags, embedded struct and self-reference
+	params := blocks.Params
+	assert.Equal(t, params.Type, "object")
+	assert.Equal(t, len(params.Fields), 3)
+	assert.Equal(t, params.Fields[0], &FieldSchema{"height", &TypeSchema{Type: "integer", GoType: "int64"}})
+	assert.Equal(t, params.Fields[1].Name, "Addresses")
+	assert.Equal(t, params.Fields[1].Schema.Items.GoType, "common.Address")
+	assert.Equal(t, params.Fields[2].Name, "Next")
+	assert.Equal(t, params.Fields[2].Schema.GoType, "rpc.DescribeTestRequest")
+	assert.Equal(t, len(params.Fields[2].Schema.Fields), 0)
+
+	// all modules sorted by namespace
+	assert.Equal(t, service.Describe(nil, &descriptions), nil)
+	assert.Equal(t, len(descriptions), 3)
+	assert.Equal(t, descriptions[0].Namespace, "debug")
+	assert.Equal(t, descriptions[0].Public, false)
+	assert.Equal(t, descriptions[1].Namespace, MetadataAPI)
+	assert.Equal(t, len(descriptions[1].Methods), 2)
+
+	namespace = "unknown"
+	assert.Equal(t, service.Describe(&namespace, &descriptions), errModuleNotFound)
+}
@@ -6,6 +6,7 @@
 package rpc
 
 import (
+	"compress/gzip"
 	"errors"
 	"io"
 	"net"
@@ -23,13 +24,16 @@ var (
 
 // HTTPServer represents a HTTP RPC server
 type HTTPServer struct {
-	rpc *rpc.Server
+	rpc     *rpc.Server
+	auth    *Authenticator
+	limiter *Limiter
 }
 
 // NewHTTPServer returns a new HttpServer and a http handler used by cors
 func NewHTTPServer(whitehosts []string, corsList []string) (*HTTPServer, *hostFilter) {
 	server := &HTTPServer{
-		rpc: &rpc.Server{},
+		rpc:     &rpc.Server{},
+		limiter: NewLimiter(LimiterConfig{}),
 	}
 	// cors
 	c := cors.New(cors.Options{
@@ -51,16 +55,49 @@ func NewHTTPServer(whitehosts []string, corsList []string) (*HTTPServer, *hostFi
 
 // ServeHTTP implements an http.Handler that answers RPC requests.
 // Supports POST and CONNECT http method.
-// POST handles requests from the browser
+// POST handles JSON-RPC 2.0 single or batch requests from the browser, curl, etc.
 // CONNECT handles requests form other go rpc.Client
 func (server *HTTPServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	switch req.Method {
 	case http.MethodConnect:
+		// The requests over CONNECT could not be authorized per method,
+		// so the authentication is always required if enabled.
+		if server.auth != nil {
+			if err := server.auth.Authenticate(req.Header); err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+		}
+
 		server.rpc.ServeHTTP(w, req)
 	case http.MethodPost:
+		maxRequestSize := server.limiter.maxRequestSize()
+		if req.ContentLength > maxRequestSize {
+			http.Error(w, "request content too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
-		conn := &httpReadWriteCloser{req.Body, w}
-		server.rpc.ServeRequest(NewJSONCodec(conn, server.rpc))
+
+		var writer io.Writer = w
+		if strings.Contains(req.Header.Get("Accept-Encoding"), "gzip") {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Header().Add("Vary", "Accept-Encoding")
+
+			gw := gzip.NewWriter(w)
+			defer gw.Close()
+			writer = gw
+		}
+
+		body := http.MaxBytesReader(w, req.Body, maxRequestSize)
+		conn := &httpReadWriteCloser{body, writer}
+		codec := newJSONCodec(conn, server.rpc)
+		server.limiter.apply(codec, remoteHost(req.RemoteAddr))
+		if server.auth != nil {
+			codec.authorize = server.auth.authorizer(req.Header)
+		}
+
+		server.rpc.ServeRequest(codec)
 	default:
 		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 		w.WriteHeader(http.StatusMethodNotAllowed)
@@ -68,6 +105,18 @@ func (server *HTTPServer) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// SetAuthenticator enables the authentication of private API namespaces.
+func (server *HTTPServer) SetAuthenticator(auth *Authenticator) {
+	server.auth = auth
+}
+
+// SetLimiter limits the requests with the specified limiter, which could be shared
+// with other servers. Note, the concurrent requests are not limited since HTTP
+// requests on a connection are served one by one.
+func (server *HTTPServer) SetLimiter(limiter *Limiter) {
+	server.limiter = limiter
+}
+
 // GetRPCServer return rpc server of the HTTPServer
 func (server *HTTPServer) GetRPCServer() *rpc.Server {
 	return server.rpc
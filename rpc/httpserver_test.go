@@ -6,10 +6,15 @@
 package rpc
 
 import (
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"github.com/magiconair/properties/assert"
 )
 
 var (
@@ -63,3 +68,127 @@ func Test_HTTPServe(t *testing.T) {
 		t.Fatalf("HTTPServe test failed")
 	}
 }
+
+func Test_HTTPServe_NamespaceNotExposed(t *testing.T) {
+	serve, _ := NewHTTPServer(nil, nil)
+	if err := serve.GetRPCServer().RegisterName("Test", new(WSTest)); err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"miner.Start","params":[1]}`
+	req := httptest.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(body))
+	req.Header.Set("content-type", "application/json")
+
+	w := httptest.NewRecorder()
+	serve.ServeHTTP(w, req)
+
+	var resp struct {
+		Error *Error `json:"error"`
+	}
+
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Error == nil || resp.Error.Code != errMethod.Code {
+		t.Fatalf("expected method not found error, got %s", w.Body.String())
+	}
+}
+
+func newTestHTTPServer(t *testing.T) *HTTPServer {
+	server, _ := NewHTTPServer(nil, nil)
+	if err := server.GetRPCServer().RegisterName("Test", new(WSTest)); err != nil {
+		t.Fatal(err)
+	}
+
+	return server
+}
+
+func postHTTPRequest(server *HTTPServer, body string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "http://url.com", strings.NewReader(body))
+	req.Header.Set("content-type", "application/json")
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, req)
+
+	return w
+}
+
+func Test_HTTPServe_JSONRPC(t *testing.T) {
+	server := newTestHTTPServer(t)
+
+	// single request
+	w := postHTTPRequest(server, `{"jsonrpc":"2.0","id":1,"method":"Test.Echo","params":["a"]}`, nil)
+	assert.Equal(t, w.Code, http.StatusOK)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"jsonrpc":"2.0","id":1,"result":"a"}`)
+
+	// null id is responded
+	w = postHTTPRequest(server, `{"jsonrpc":"2.0","id":null,"method":"Test.Echo","params":["a"]}`, nil)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"jsonrpc":"2.0","id":null,"result":"a"}`)
+
+	// notification is not responded
+	w = postHTTPRequest(server, `{"jsonrpc":"2.0","method":"Test.Echo","params":["a"]}`, nil)
+	assert.Equal(t, w.Body.Len(), 0)
+
+	// batch with notification
+	w = postHTTPRequest(server, `[{"jsonrpc":"2.0","id":1,"method":"Test.Echo","params":["a"]},`+
+		`{"jsonrpc":"2.0","method":"Test.Echo","params":["b"]},`+
+		`{"jsonrpc":"2.0","id":2,"method":"Test.Unknown","params":["c"]}]`, nil)
+
+	var resps []struct {
+		ID     int    `json:"id"`
+		Result string `json:"result"`
+		Error  *Error `json:"error"`
+	}
+
+	if err := json.Unmarshal(w.Body.Bytes(), &resps); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(resps), 2)
+	for _, resp := range resps {
+		if resp.ID == 1 {
+			assert.Equal(t, resp.Result, "a")
+		} else {
+			assert.Equal(t, resp.ID, 2)
+			assert.Equal(t, resp.Error.Code, errMethod.Code)
+		}
+	}
+
+	// parse error
+	w = postHTTPRequest(server, `{"jsonrpc"`, nil)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"jsonrpc":"2.0","id":null,"error":{"code":-32700,"message":"Parse error"}}`)
+
+	// empty batch
+	w = postHTTPRequest(server, `[]`, nil)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"Invalid request"}}`)
+}
+
+func Test_HTTPServe_Gzip(t *testing.T) {
+	server := newTestHTTPServer(t)
+
+	w := postHTTPRequest(server, `{"jsonrpc":"2.0","id":1,"method":"Test.Echo","params":["a"]}`, map[string]string{"Accept-Encoding": "gzip"})
+	assert.Equal(t, w.Header().Get("Content-Encoding"), "gzip")
+
+	reader, err := gzip.NewReader(w.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, strings.TrimSpace(string(body)), `{"jsonrpc":"2.0","id":1,"result":"a"}`)
+}
+
+func Test_HTTPServe_TooLarge(t *testing.T) {
+	server := newTestHTTPServer(t)
+
+	w := postHTTPRequest(server, strings.Repeat(" ", defaultMaxRequestSize+1), nil)
+	assert.Equal(t, w.Code, http.StatusRequestEntityTooLarge)
+}
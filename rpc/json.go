@@ -17,13 +17,15 @@ const (
 )
 
 type jsonCodec struct {
-	dec *json.Decoder // for reading JSON values
-	enc *json.Encoder // for writing JSON values
-	c   io.Closer
-	srv *rpc.Server
+	reader *requestReader // limits the size of a single request
+	dec    *json.Decoder  // for reading JSON values
+	enc    *json.Encoder  // for writing JSON values
+	c      io.Closer
+	srv    *rpc.Server
 
 	// temporary work space
-	req jsonRequest
+	req       jsonRequest
+	throttled *Error // error of the throttled request
 
 	// JSON-RPC clients can use arbitrary json values as request IDs.
 	// Package rpc expects uint64 request IDs.
@@ -35,16 +37,33 @@ type jsonCodec struct {
 	encmutex sync.Mutex // protects enc
 	seq      uint64
 	pending  map[uint64]*json.RawMessage
+
+	// authorize returns error if the request method is not allowed, e.g. private
+	// method without authentication, and all methods are allowed if nil.
+	authorize func(method string) error
+
+	// throttle returns error if the request method is throttled, e.g. rate
+	// limit exceeded, and no request is throttled if nil.
+	throttle func(method string) *Error
+
+	// maxConcurrent is the max number of requests served concurrently, unlimited if 0.
+	maxConcurrent int
 }
 
 // NewJSONCodec returns a new rpc.ServerCodec using JSON-RPC on conn.
 func NewJSONCodec(conn io.ReadWriteCloser, srv *rpc.Server) rpc.ServerCodec {
+	return newJSONCodec(conn, srv)
+}
+
+func newJSONCodec(conn io.ReadWriteCloser, srv *rpc.Server) *jsonCodec {
 	if srv == nil {
 		srv = rpc.DefaultServer
 	}
 	srv.Register(JSONRPC2{})
+	reader := &requestReader{r: conn}
 	return &jsonCodec{
-		dec:     json.NewDecoder(conn),
+		reader:  reader,
+		dec:     json.NewDecoder(reader),
 		enc:     json.NewEncoder(conn),
 		c:       conn,
 		srv:     srv,
@@ -56,13 +75,13 @@ type jsonRequest struct {
 	Version string           `json:"jsonrpc"`
 	Method  string           `json:"method"`
 	Params  *json.RawMessage `json:"params"`
-	ID      *json.RawMessage `json:"id"`
+	ID      *json.RawMessage `json:"id"` // nil for notification, which requires no response
 }
 
 func (r *jsonRequest) UnmarshalJSON(raw []byte) error {
 	r.reset()
-	type req *jsonRequest
-	if err := json.Unmarshal(raw, req(r)); err != nil {
+	type req jsonRequest
+	if err := json.Unmarshal(raw, (*req)(r)); err != nil {
 		return errors.New("bad request")
 	}
 
@@ -87,7 +106,8 @@ func (r *jsonRequest) UnmarshalJSON(raw []byte) error {
 		}
 	}
 	if okID && r.ID == nil {
-		r.ID = nil
+		// "id":null is not a notification, and should be responded.
+		r.ID = &null
 	}
 	if okID {
 		if len(*r.ID) == 0 {
@@ -118,16 +138,22 @@ type jsonResponse struct {
 
 func (c *jsonCodec) ReadRequestHeader(r *rpc.Request) error {
 	var raw json.RawMessage
+	c.reader.reset()
 	if err := c.dec.Decode(&raw); err != nil {
+		respErr := errParse
+		if c.reader.exceeded() {
+			respErr = errRequestTooLarge
+		}
+
 		c.encmutex.Lock()
-		c.enc.Encode(jsonResponse{Version: jsonrpcVersion, ID: &null, Error: errParse})
+		c.enc.Encode(jsonResponse{Version: jsonrpcVersion, ID: &null, Error: respErr})
 		c.encmutex.Unlock()
 		return err
 	}
 
 	if len(raw) > 0 && raw[0] == '[' {
 		c.req.Version = jsonrpcVersion
-		c.req.Method = "JSONRPC2.Batch"
+		c.req.Method = batchMethod
 		c.req.Params = &raw
 		c.req.ID = &null
 	} else if err := json.Unmarshal(raw, &c.req); err != nil {
@@ -139,6 +165,25 @@ func (c *jsonCodec) ReadRequestHeader(r *rpc.Request) error {
 		return err
 	}
 
+	c.mutex.Lock()
+	concurrent := len(c.pending)
+	c.mutex.Unlock()
+
+	// The throttled and unauthorized requests are served by internal methods that
+	// return error, so that the error response will be written with the request id.
+	c.throttled = nil
+	if c.maxConcurrent > 0 && concurrent >= c.maxConcurrent {
+		c.throttled = errTooManyRequests
+	} else if c.throttle != nil && c.req.Method != batchMethod {
+		c.throttled = c.throttle(c.req.Method)
+	}
+
+	if c.throttled != nil {
+		c.req.Method = throttledMethod
+	} else if c.authorize != nil && c.req.Method != batchMethod && c.authorize(c.req.Method) != nil {
+		c.req.Method = unauthorizedMethod
+	}
+
 	r.ServiceMethod = c.req.Method
 
 	// JSON request id can be any JSON value;
@@ -159,6 +204,15 @@ func (c *jsonCodec) ReadRequestBody(x interface{}) error {
 		return nil
 	}
 
+	if c.req.Method == unauthorizedMethod {
+		return nil
+	}
+
+	if c.req.Method == throttledMethod {
+		*x.(*Error) = *c.throttled
+		return nil
+	}
+
 	if c.req.Params == nil {
 		return errParams
 	}
@@ -169,9 +223,11 @@ func (c *jsonCodec) ReadRequestBody(x interface{}) error {
 	var params [1]interface{}
 	params[0] = x
 
-	if c.req.Method == "JSONRPC2.Batch" {
+	if c.req.Method == batchMethod {
 		arg := x.(*BatchArg)
 		arg.srv = c.srv
+		arg.authorize = c.authorize
+		arg.throttle = c.throttle
 		if err := json.Unmarshal(*c.req.Params, &arg.reqs); err != nil {
 			return NewError(errParams.Code, err.Error())
 		}
@@ -197,7 +253,7 @@ func (c *jsonCodec) WriteResponse(r *rpc.Response, x interface{}) error {
 	delete(c.pending, r.Seq)
 	c.mutex.Unlock()
 
-	if replies, ok := x.(*[]*json.RawMessage); r.ServiceMethod == "JSONRPC2.Batch" && ok {
+	if replies, ok := x.(*[]*json.RawMessage); r.ServiceMethod == batchMethod && ok {
 		if len(*replies) == 0 {
 			return nil
 		}
@@ -207,9 +263,10 @@ func (c *jsonCodec) WriteResponse(r *rpc.Response, x interface{}) error {
 	}
 
 	if b == nil {
-		// Invalid request so no id. Use JSON null.
-		b = &null
+		// Notification request, no response required.
+		return nil
 	}
+
 	resp := jsonResponse{Version: jsonrpcVersion, ID: b}
 	if r.Error == "" {
 		if x == nil {
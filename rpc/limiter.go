@@ -0,0 +1,217 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"io"
+	"net"
+	"net/rpc"
+	"sync"
+	"time"
+
+	"github.com/seeleteam/go-seele/p2p/qvic"
+)
+
+const (
+	// defaultMaxRequestSize is the default max size in bytes of a single request.
+	defaultMaxRequestSize = 5 * 1024 * 1024
+
+	// tokenScale scales the request tokens in bucket, since the token
+	// bucket refills integral tokens every millisecond.
+	tokenScale = 1000
+
+	// bucketIdleTimeout is the duration after which the bucket of an idle client is removed.
+	bucketIdleTimeout = 10 * time.Minute
+)
+
+var (
+	errRateLimited     = NewError(-32005, "Request rate limit exceeded")
+	errTooManyRequests = NewError(-32005, "Too many concurrent requests")
+	errRequestTooLarge = NewError(errRequest.Code, "Request too large")
+)
+
+// LimiterConfig is the configuration of RPC request limits.
+type LimiterConfig struct {
+	// RequestsPerSecond is the number of request tokens refilled per second for
+	// each client IP, which is also the burst size. No rate limit if 0.
+	RequestsPerSecond int64 `json:"requestsPerSecond"`
+
+	// MethodCosts is the number of tokens consumed by a request of the method,
+	// e.g. "seele.GetBlockByHeight". The cost of unspecified methods is 1.
+	MethodCosts map[string]int64 `json:"methodCosts"`
+
+	// MaxConcurrentRequests is the max number of requests served concurrently
+	// on a single connection. Unlimited if 0.
+	MaxConcurrentRequests int `json:"maxConcurrentRequests"`
+
+	// MaxRequestSize is the max size in bytes of a single request, 5MB if 0.
+	MaxRequestSize int64 `json:"maxRequestSize"`
+}
+
+// Limiter limits the RPC requests with per-IP token buckets, and it is
+// shared by all the RPC transports so that a client is limited as a whole.
+type Limiter struct {
+	config LimiterConfig
+	clock  qvic.Clock
+
+	lock      sync.Mutex
+	buckets   map[string]*clientBucket // client host to bucket
+	lastPrune uint64                   // in milliseconds
+}
+
+// clientBucket is the token bucket of a client.
+type clientBucket struct {
+	qvic.TokenBucket
+	lastSeen uint64 // in milliseconds
+}
+
+// NewLimiter returns a new Limiter with the specified configuration.
+func NewLimiter(config LimiterConfig) *Limiter {
+	return newLimiterWithClock(config, qvic.MonotonicClock)
+}
+
+// newLimiterWithClock returns a new Limiter of which the token buckets are refilled with the specified clock.
+func newLimiterWithClock(config LimiterConfig, clock qvic.Clock) *Limiter {
+	return &Limiter{
+		config:    config,
+		clock:     clock,
+		buckets:   make(map[string]*clientBucket),
+		lastPrune: clock(),
+	}
+}
+
+// maxRequestSize returns the max size in bytes of a single request.
+func (l *Limiter) maxRequestSize() int64 {
+	if l.config.MaxRequestSize > 0 {
+		return l.config.MaxRequestSize
+	}
+
+	return defaultMaxRequestSize
+}
+
+// allow consumes the tokens of the method cost from the bucket of the specified
+// client host, and returns error if the tokens are not enough.
+func (l *Limiter) allow(host string, method string) *Error {
+	if l.config.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	cost, ok := l.config.MethodCosts[method]
+	if !ok {
+		cost = 1
+	}
+
+	if cost <= 0 {
+		return nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock()
+	l.prune(now)
+
+	client, ok := l.buckets[host]
+	if !ok {
+		client = &clientBucket{}
+		client.InitWithClock(l.config.RequestsPerSecond*tokenScale, l.clock)
+		l.buckets[host] = client
+	}
+
+	client.lastSeen = now
+	client.PeriodicFeed()
+
+	if client.GetCurTokens() < cost*tokenScale {
+		return errRateLimited
+	}
+
+	client.Consume(cost * tokenScale)
+
+	return nil
+}
+
+// prune removes the buckets of idle clients periodically.
+func (l *Limiter) prune(now uint64) {
+	idleTimeout := uint64(bucketIdleTimeout / time.Millisecond)
+	if now-l.lastPrune < idleTimeout {
+		return
+	}
+
+	for host, client := range l.buckets {
+		if now-client.lastSeen >= idleTimeout {
+			delete(l.buckets, host)
+		}
+	}
+
+	l.lastPrune = now
+}
+
+// throttler returns the function to throttle the request methods of the specified client host.
+func (l *Limiter) throttler(host string) func(method string) *Error {
+	return func(method string) *Error {
+		return l.allow(host, method)
+	}
+}
+
+// apply applies the limits to the codec that serves requests from the specified client host.
+func (l *Limiter) apply(codec *jsonCodec, host string) {
+	codec.throttle = l.throttler(host)
+	codec.maxConcurrent = l.config.MaxConcurrentRequests
+	codec.reader.limit = l.maxRequestSize()
+}
+
+// NewLimitedJSONCodec returns a new rpc.ServerCodec using JSON-RPC on conn,
+// and the requests are limited by the specified limiter.
+func NewLimitedJSONCodec(conn net.Conn, srv *rpc.Server, limiter *Limiter) rpc.ServerCodec {
+	codec := newJSONCodec(conn, srv)
+	limiter.apply(codec, remoteHost(conn.RemoteAddr().String()))
+	return codec
+}
+
+// remoteHost returns the host of the remote address, e.g. IP. Note, the whole
+// address is returned if no port in it, e.g. Unix domain socket.
+func remoteHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+
+	return host
+}
+
+// requestReader limits the bytes read for a single request,
+// and should be reset before reading a new request.
+type requestReader struct {
+	r     io.Reader
+	limit int64 // unlimited if 0
+	n     int64 // bytes read since reset
+}
+
+func (r *requestReader) Read(p []byte) (int, error) {
+	if r.limit > 0 {
+		if r.n >= r.limit {
+			return 0, errRequestTooLarge
+		}
+
+		if remain := r.limit - r.n; int64(len(p)) > remain {
+			p = p[:remain]
+		}
+	}
+
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+
+	return n, err
+}
+
+func (r *requestReader) reset() {
+	r.n = 0
+}
+
+// exceeded indicates whether the bytes read since reset reached the limit.
+func (r *requestReader) exceeded() bool {
+	return r.limit > 0 && r.n >= r.limit
+}
@@ -0,0 +1,176 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/rpc"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+)
+
+func Test_Limiter_Allow(t *testing.T) {
+	var now uint64 = 1000
+	limiter := newLimiterWithClock(LimiterConfig{
+		RequestsPerSecond: 2,
+		MethodCosts:       map[string]int64{"Test.Heavy": 2, "Test.Free": 0},
+	}, func() uint64 { return now })
+
+	// burst
+	assert.Equal(t, limiter.allow("a", "Test.Echo"), (*Error)(nil))
+	assert.Equal(t, limiter.allow("a", "Test.Echo"), (*Error)(nil))
+	assert.Equal(t, limiter.allow("a", "Test.Echo"), errRateLimited)
+	assert.Equal(t, limiter.allow("a", "Test.Free"), (*Error)(nil))
+
+	// buckets per client
+	assert.Equal(t, limiter.allow("b", "Test.Echo"), (*Error)(nil))
+	assert.Equal(t, limiter.allow("b", "Test.Heavy"), errRateLimited)
+
+	// refilled at the rate of requests per second
+	now += 500
+	assert.Equal(t, limiter.allow("a", "Test.Echo"), (*Error)(nil))
+	assert.Equal(t, limiter.allow("a", "Test.Echo"), errRateLimited)
+
+	// no rate limit by default
+	limiter = NewLimiter(LimiterConfig{})
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, limiter.allow("a", "Test.Echo"), (*Error)(nil))
+	}
+
+	assert.Equal(t, limiter.maxRequestSize(), int64(defaultMaxRequestSize))
+}
+
+func Test_Limiter_Prune(t *testing.T) {
+	var now uint64 = 1000
+	limiter := newLimiterWithClock(LimiterConfig{RequestsPerSecond: 1}, func() uint64 { return now })
+
+	assert.Equal(t, limiter.allow("a", "Test.Echo"), (*Error)(nil))
+	now += 1000
+	assert.Equal(t, limiter.allow("b", "Test.Echo"), (*Error)(nil))
+
+	// bucket of client a is idle
+	now += uint64(bucketIdleTimeout/time.Millisecond) - 500
+	assert.Equal(t, limiter.allow("b", "Test.Echo"), (*Error)(nil))
+	assert.Equal(t, len(limiter.buckets), 1)
+	assert.Equal(t, limiter.buckets["b"] != nil, true)
+}
+
+func Test_HTTPServe_RateLimited(t *testing.T) {
+	server := newTestHTTPServer(t)
+	server.SetLimiter(NewLimiter(LimiterConfig{RequestsPerSecond: 1}))
+
+	w := postHTTPRequest(server, `{"jsonrpc":"2.0","id":1,"method":"Test.Echo","params":["a"]}`, nil)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"jsonrpc":"2.0","id":1,"result":"a"}`)
+
+	w = postHTTPRequest(server, `{"jsonrpc":"2.0","id":2,"method":"Test.Echo","params":["a"]}`, nil)
+	assert.Equal(t, strings.TrimSpace(w.Body.String()), `{"jsonrpc":"2.0","id":2,"error":{"code":-32005,"message":"Request rate limit exceeded"}}`)
+
+	// requests in batch are limited one by one
+	server.SetLimiter(NewLimiter(LimiterConfig{RequestsPerSecond: 1}))
+	w = postHTTPRequest(server, `[{"jsonrpc":"2.0","id":1,"method":"Test.Echo","params":["a"]},`+
+		`{"jsonrpc":"2.0","id":2,"method":"Test.Echo","params":["b"]}]`, nil)
+
+	var resps []struct {
+		ID    int    `json:"id"`
+		Error *Error `json:"error"`
+	}
+
+	if err := json.Unmarshal(w.Body.Bytes(), &resps); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, len(resps), 2)
+
+	limited := 0
+	for _, resp := range resps {
+		if resp.Error != nil {
+			assert.Equal(t, resp.Error.Code, errRateLimited.Code)
+			limited++
+		}
+	}
+
+	assert.Equal(t, limited, 1)
+}
+
+type testBlockingAPI struct {
+	release chan struct{}
+}
+
+func (api *testBlockingAPI) Wait(input *string, result *string) error {
+	<-api.release
+	*result = *input
+	return nil
+}
+
+type testLimitResponse struct {
+	ID     int    `json:"id"`
+	Result string `json:"result"`
+	Error  *Error `json:"error"`
+}
+
+func newTestLimitedConn(t *testing.T, config LimiterConfig) (net.Conn, *testBlockingAPI) {
+	api := &testBlockingAPI{make(chan struct{})}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("Test", api); err != nil {
+		t.Fatal(err)
+	}
+
+	cli, srv := net.Pipe()
+	go server.ServeCodec(NewLimitedJSONCodec(srv, server, NewLimiter(config)))
+
+	return cli, api
+}
+
+func Test_JSONCodec_TooManyRequests(t *testing.T) {
+	cli, api := newTestLimitedConn(t, LimiterConfig{MaxConcurrentRequests: 1})
+	defer cli.Close()
+
+	go func() {
+		fmt.Fprintf(cli, `{"jsonrpc":"2.0","id":1,"method":"Test.Wait","params":["a"]}`)
+		fmt.Fprintf(cli, `{"jsonrpc":"2.0","id":2,"method":"Test.Wait","params":["b"]}`)
+	}()
+
+	dec := json.NewDecoder(cli)
+
+	var resp testLimitResponse
+	if err := dec.Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, resp.ID, 2)
+	assert.Equal(t, resp.Error, errTooManyRequests)
+
+	close(api.release)
+
+	resp = testLimitResponse{}
+	if err := dec.Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, resp.ID, 1)
+	assert.Equal(t, resp.Result, "a")
+}
+
+func Test_JSONCodec_RequestTooLarge(t *testing.T) {
+	cli, api := newTestLimitedConn(t, LimiterConfig{MaxRequestSize: 64})
+	defer cli.Close()
+	close(api.release)
+
+	go fmt.Fprintf(cli, `{"jsonrpc":"2.0","id":1,"method":"Test.Wait","params":["%s"]}`, strings.Repeat("a", 64))
+
+	var resp testLimitResponse
+	if err := json.NewDecoder(cli).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, resp.Error, errRequestTooLarge)
+}
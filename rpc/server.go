@@ -9,7 +9,7 @@ import (
 	"net/rpc"
 )
 
-// MetadataAPI is a default service for RegisterName.
+// MetadataAPI is the namespace of RPCService, which describes the served APIs.
 const MetadataAPI = "rpc"
 
 // Server represents a RPC server
@@ -29,21 +29,11 @@ type API struct {
 	Public bool
 }
 
-// RPCService offers meta information of the server.
-//type RPCService struct {
-//	server *Server
-//}
-
 // NewServer returns a new Server.
 func NewServer() *Server {
 	server := &Server{
 		rpc.Server{},
 	}
 
-	// Not implemented service
-	// register a default service which will provide meta information about the RPC service.
-	//rpcService := &RPCService{server}
-	//server.RegisterName(MetadataAPI, rpcService)
-
 	return server
 }
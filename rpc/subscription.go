@@ -0,0 +1,248 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/rpc"
+	"strings"
+	"sync"
+
+	"github.com/seeleteam/go-seele/common/hexutil"
+)
+
+const (
+	subscribeMethod    = "Subscribe"
+	unsubscribeMethod  = "Unsubscribe"
+	notificationMethod = "Subscription"
+)
+
+var (
+	errSubscriptionNotFound = errors.New("subscription not found")
+)
+
+// Subscriber is implemented by services that support subscriptions, which push
+// notifications to the client over a persistent connection, e.g. websocket.
+type Subscriber interface {
+	// Subscribe creates a subscription of the specified name with optional args in JSON.
+	// The notify function is used to push notifications to the client, and returns
+	// error if failed to push, e.g. connection closed. The returned unsubscribe function
+	// is called when the client unsubscribes or disconnects.
+	Subscribe(name string, args *json.RawMessage, notify func(data interface{}) error) (unsubscribe func(), err error)
+}
+
+// subscriptionNotification represents the params of a subscription notification.
+type subscriptionNotification struct {
+	Subscription string      `json:"subscription"`
+	Result       interface{} `json:"result"`
+}
+
+// jsonNotification represents a JSON-RPC 2.0 notification, which has no id.
+type jsonNotification struct {
+	Version string                   `json:"jsonrpc"`
+	Method  string                   `json:"method"`
+	Params  subscriptionNotification `json:"params"`
+}
+
+// subscriptionCodec is a JSON codec that handles the subscribe and unsubscribe
+// requests of registered subscribers, and passes other requests to the RPC server.
+// For a subscriber registered with namespace "ns", the methods are "ns.Subscribe"
+// and "ns.Unsubscribe", and notifications are pushed with method "ns.Subscription".
+type subscriptionCodec struct {
+	*jsonCodec
+	subscribers map[string]Subscriber // namespace to subscriber
+
+	lock          sync.Mutex
+	closed        bool
+	subscriptions map[string]func() // subscription id to unsubscribe function
+}
+
+func newSubscriptionCodec(conn io.ReadWriteCloser, srv *rpc.Server, subscribers map[string]Subscriber) *subscriptionCodec {
+	return &subscriptionCodec{
+		jsonCodec:     newJSONCodec(conn, srv),
+		subscribers:   subscribers,
+		subscriptions: make(map[string]func()),
+	}
+}
+
+// ReadRequestHeader reads the next request that should be served by the RPC server.
+// The subscription requests are served by the codec directly.
+func (c *subscriptionCodec) ReadRequestHeader(r *rpc.Request) error {
+	for {
+		if err := c.jsonCodec.ReadRequestHeader(r); err != nil {
+			return err
+		}
+
+		if !c.handleSubscription(r) {
+			return nil
+		}
+	}
+}
+
+// handleSubscription handles the subscription request if any,
+// and returns false if the request is not a subscription request.
+func (c *subscriptionCodec) handleSubscription(r *rpc.Request) bool {
+	dot := strings.LastIndex(r.ServiceMethod, ".")
+	if dot < 0 {
+		return false
+	}
+
+	namespace, method := r.ServiceMethod[:dot], r.ServiceMethod[dot+1:]
+	subscriber, ok := c.subscribers[namespace]
+	if !ok || (method != subscribeMethod && method != unsubscribeMethod) {
+		return false
+	}
+
+	c.mutex.Lock()
+	id := c.pending[r.Seq]
+	delete(c.pending, r.Seq)
+	c.mutex.Unlock()
+
+	if c.authorize != nil && c.authorize(r.ServiceMethod) != nil {
+		c.writeResponse(id, nil, errUnauthorized)
+		return true
+	}
+
+	var params []*json.RawMessage
+	if c.req.Params == nil || json.Unmarshal(*c.req.Params, &params) != nil || len(params) == 0 || params[0] == nil {
+		c.writeResponse(id, nil, errParams)
+		return true
+	}
+
+	var name string
+	if err := json.Unmarshal(*params[0], &name); err != nil {
+		c.writeResponse(id, nil, NewError(errParams.Code, err.Error()))
+		return true
+	}
+
+	if method == unsubscribeMethod {
+		if err := c.unsubscribe(name); err != nil {
+			c.writeResponse(id, nil, NewError(errParams.Code, err.Error()))
+		} else {
+			c.writeResponse(id, true, nil)
+		}
+
+		return true
+	}
+
+	var args *json.RawMessage
+	if len(params) > 1 {
+		args = params[1]
+	}
+
+	if subID, err := c.subscribe(namespace, subscriber, name, args); err != nil {
+		c.writeResponse(id, nil, NewError(errServer.Code, err.Error()))
+	} else {
+		c.writeResponse(id, subID, nil)
+	}
+
+	return true
+}
+
+// subscribe creates a subscription and returns the subscription id.
+func (c *subscriptionCodec) subscribe(namespace string, subscriber Subscriber, name string, args *json.RawMessage) (string, error) {
+	subID, err := newSubscriptionID()
+	if err != nil {
+		return "", err
+	}
+
+	notifyMethod := namespace + "." + notificationMethod
+	notify := func(data interface{}) error {
+		return c.writeNotification(notifyMethod, subID, data)
+	}
+
+	// Hold the lock until the subscription is recorded, so that the
+	// unsubscribe function will always be called when connection closed.
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return "", io.ErrClosedPipe
+	}
+
+	unsubscribe, err := subscriber.Subscribe(name, args, notify)
+	if err != nil {
+		return "", err
+	}
+
+	c.subscriptions[subID] = unsubscribe
+
+	return subID, nil
+}
+
+// unsubscribe cancels the subscription of the specified id.
+func (c *subscriptionCodec) unsubscribe(subID string) error {
+	c.lock.Lock()
+	unsubscribe, ok := c.subscriptions[subID]
+	delete(c.subscriptions, subID)
+	c.lock.Unlock()
+
+	if !ok {
+		return errSubscriptionNotFound
+	}
+
+	unsubscribe()
+
+	return nil
+}
+
+func (c *subscriptionCodec) writeResponse(id *json.RawMessage, result interface{}, err *Error) {
+	if id == nil {
+		// Notification request, no response required.
+		return
+	}
+
+	resp := jsonResponse{Version: jsonrpcVersion, ID: id}
+	if err != nil {
+		resp.Error = err
+	} else {
+		resp.Result = result
+	}
+
+	c.encmutex.Lock()
+	defer c.encmutex.Unlock()
+	c.enc.Encode(resp)
+}
+
+func (c *subscriptionCodec) writeNotification(method string, subID string, data interface{}) error {
+	notification := jsonNotification{
+		Version: jsonrpcVersion,
+		Method:  method,
+		Params:  subscriptionNotification{subID, data},
+	}
+
+	c.encmutex.Lock()
+	defer c.encmutex.Unlock()
+	return c.enc.Encode(notification)
+}
+
+// Close cancels all the subscriptions and closes the connection.
+func (c *subscriptionCodec) Close() error {
+	c.lock.Lock()
+	c.closed = true
+	subscriptions := c.subscriptions
+	c.subscriptions = make(map[string]func())
+	c.lock.Unlock()
+
+	for _, unsubscribe := range subscriptions {
+		unsubscribe()
+	}
+
+	return c.jsonCodec.Close()
+}
+
+// newSubscriptionID returns a random subscription id in HEX.
+func newSubscriptionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hexutil.BytesToHex(id), nil
+}
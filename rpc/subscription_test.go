@@ -0,0 +1,139 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/magiconair/properties/assert"
+)
+
+type testSubscriber struct {
+	notify       func(data interface{}) error
+	unsubscribed chan struct{}
+}
+
+func (s *testSubscriber) Subscribe(name string, args *json.RawMessage, notify func(data interface{}) error) (func(), error) {
+	if name != "test" {
+		return nil, errors.New("unknown subscription")
+	}
+
+	s.notify = notify
+
+	return func() { close(s.unsubscribed) }, nil
+}
+
+type testMessage struct {
+	ID     *json.RawMessage `json:"id"`
+	Method string           `json:"method"`
+	Result json.RawMessage  `json:"result"`
+	Error  *Error           `json:"error"`
+	Params struct {
+		Subscription string `json:"subscription"`
+		Result       string `json:"result"`
+	} `json:"params"`
+}
+
+func newTestWsConn(t *testing.T, subscriber Subscriber) (*websocket.Conn, func()) {
+	server := NewWsRPCServer()
+	if err := server.GetWsRPCServer().RegisterName("Test", new(WSTest)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.RegisterSubscriber("Test", subscriber); err != nil {
+		t.Fatal(err)
+	}
+
+	httpServer := httptest.NewServer(http.HandlerFunc(server.ServeWS))
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(httpServer.URL, "http"), nil)
+	if err != nil {
+		httpServer.Close()
+		t.Fatal(err)
+	}
+
+	return conn, func() {
+		conn.Close()
+		httpServer.Close()
+	}
+}
+
+func readTestMessage(t *testing.T, conn *websocket.Conn) *testMessage {
+	var msg testMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		t.Fatal(err)
+	}
+
+	return &msg
+}
+
+func Test_Subscription(t *testing.T) {
+	subscriber := &testSubscriber{unsubscribed: make(chan struct{})}
+	conn, dispose := newTestWsConn(t, subscriber)
+	defer dispose()
+
+	// normal RPC call
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"Test.Echo","params":["hello"]}`))
+	msg := readTestMessage(t, conn)
+	assert.Equal(t, msg.Error == nil, true)
+	assert.Equal(t, string(msg.Result), `"hello"`)
+
+	// unknown subscription
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":2,"method":"Test.Subscribe","params":["unknown"]}`))
+	msg = readTestMessage(t, conn)
+	assert.Equal(t, msg.Error != nil, true)
+
+	// subscribe
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":3,"method":"Test.Subscribe","params":["test"]}`))
+	msg = readTestMessage(t, conn)
+	assert.Equal(t, msg.Error == nil, true)
+
+	var subID string
+	if err := json.Unmarshal(msg.Result, &subID); err != nil {
+		t.Fatal(err)
+	}
+
+	// notification
+	if err := subscriber.notify("data"); err != nil {
+		t.Fatal(err)
+	}
+
+	msg = readTestMessage(t, conn)
+	assert.Equal(t, msg.ID == nil, true)
+	assert.Equal(t, msg.Method, "Test.Subscription")
+	assert.Equal(t, msg.Params.Subscription, subID)
+	assert.Equal(t, msg.Params.Result, "data")
+
+	// unsubscribe
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":4,"method":"Test.Unsubscribe","params":["`+subID+`"]}`))
+	msg = readTestMessage(t, conn)
+	assert.Equal(t, msg.Error == nil, true)
+	assert.Equal(t, string(msg.Result), "true")
+	<-subscriber.unsubscribed
+
+	// unsubscribe again
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":5,"method":"Test.Unsubscribe","params":["`+subID+`"]}`))
+	msg = readTestMessage(t, conn)
+	assert.Equal(t, msg.Error != nil, true)
+}
+
+func Test_Subscription_Disconnect(t *testing.T) {
+	subscriber := &testSubscriber{unsubscribed: make(chan struct{})}
+	conn, dispose := newTestWsConn(t, subscriber)
+
+	conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"Test.Subscribe","params":["test"]}`))
+	msg := readTestMessage(t, conn)
+	assert.Equal(t, msg.Error == nil, true)
+
+	// subscriptions are cancelled when connection closed
+	dispose()
+	<-subscriber.unsubscribed
+}
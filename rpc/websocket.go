@@ -11,6 +11,7 @@ import (
 	"log"
 	"net/http"
 	"net/rpc"
+	"sync"
 
 	"github.com/gorilla/websocket"
 )
@@ -26,11 +27,24 @@ type WSServerConfig struct {
 	WSAddr string `json:"address"`
 	// The WSAddr is the pattern of Websocket rpc service
 	WSPattern string `json:"pattern"`
+	// The WSModules is the API namespaces exposed on Websocket rpc service.
+	// Only public APIs are exposed if empty.
+	WSModules []string `json:"modules"`
+	// The WSAuth indicates whether to authenticate the requests to private APIs
+	// with bearer token signed by the secret in node data folder.
+	WSAuth bool `json:"auth"`
 }
 
 // WsRPCServer represents a Websocket RPC server
 type WsRPCServer struct {
-	rpc *rpc.Server
+	rpc         *rpc.Server
+	subscribers map[string]Subscriber
+	auth        *Authenticator
+	limiter     *Limiter
+
+	lock   sync.Mutex
+	closed bool
+	conns  map[*websocket.Conn]struct{} // active connections
 }
 
 // WebsocketServerConn represents a websocket server connection
@@ -43,7 +57,10 @@ type WebsocketServerConn struct {
 // NewWsRPCServer return a Websocket RPC server
 func NewWsRPCServer() *WsRPCServer {
 	server := &WsRPCServer{
-		rpc: &rpc.Server{},
+		rpc:         &rpc.Server{},
+		subscribers: make(map[string]Subscriber),
+		limiter:     NewLimiter(LimiterConfig{}),
+		conns:       make(map[*websocket.Conn]struct{}),
 	}
 
 	return server
@@ -54,39 +71,120 @@ func (server *WsRPCServer) GetWsRPCServer() *rpc.Server {
 	return server.rpc
 }
 
+// RegisterSubscriber registers the subscriber with the specified namespace,
+// so that clients could subscribe notifications via "namespace.Subscribe".
+// Note, it should be called before the server is started.
+func (server *WsRPCServer) RegisterSubscriber(namespace string, subscriber Subscriber) error {
+	if _, ok := server.subscribers[namespace]; ok {
+		return fmt.Errorf("subscriber already defined: %v", namespace)
+	}
+
+	server.subscribers[namespace] = subscriber
+
+	return nil
+}
+
 // ServeWS runs the JSON-RPC server on a single websocket connection.
 func (server *WsRPCServer) ServeWS(w http.ResponseWriter, r *http.Request) {
 	ws, err := upgrader.Upgrade(w, r, nil)
-	defer ws.Close()
-
 	if err != nil {
 		log.Println(err)
 		return
 	}
 
-	server.rpc.ServeCodec(NewJSONCodec(ws.UnderlyingConn(), nil))
+	if !server.track(ws) {
+		ws.Close()
+		return
+	}
+	defer server.untrack(ws)
+
+	ws.SetReadLimit(server.limiter.maxRequestSize())
+
+	codec := newSubscriptionCodec(&WebsocketServerConn{Ws: ws}, server.rpc, server.subscribers)
+	server.limiter.apply(codec.jsonCodec, remoteHost(r.RemoteAddr))
+	if server.auth != nil {
+		// authenticated by the handshake request for the whole connection
+		codec.authorize = server.auth.authorizer(r.Header)
+	}
+
+	server.rpc.ServeCodec(codec)
+}
+
+// SetAuthenticator enables the authentication of private API namespaces.
+// Note, it should be called before the server is started.
+func (server *WsRPCServer) SetAuthenticator(auth *Authenticator) {
+	server.auth = auth
+}
+
+// SetLimiter limits the requests with the specified limiter, which could be shared with other servers.
+// Note, it should be called before the server is started.
+func (server *WsRPCServer) SetLimiter(limiter *Limiter) {
+	server.limiter = limiter
+}
+
+// track records the active connection, and returns false if the server is closed.
+func (server *WsRPCServer) track(ws *websocket.Conn) bool {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	if server.closed {
+		return false
+	}
+
+	server.conns[ws] = struct{}{}
+
+	return true
+}
+
+func (server *WsRPCServer) untrack(ws *websocket.Conn) {
+	server.lock.Lock()
+	delete(server.conns, ws)
+	server.lock.Unlock()
+}
+
+// Close closes all the active connections, which are hijacked from
+// the HTTP server and will not be closed when HTTP server shutdown.
+func (server *WsRPCServer) Close() {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	server.closed = true
+	for ws := range server.conns {
+		ws.Close()
+	}
 }
 
 // Read represents read data from websocket connection.
+// Messages are read as a continuous stream, so the end of
+// a message is not reported as io.EOF to the caller.
 func (wc *WebsocketServerConn) Read(p []byte) (n int, err error) {
-	if wc.r == nil {
-		_, wc.r, err = wc.Ws.NextReader()
+	if len(p) == 0 {
+		return 0, nil
+	}
 
-		if err != nil {
-			fmt.Println(err)
-			return 0, err
+	for n == 0 {
+		if wc.r == nil {
+			if _, wc.r, err = wc.Ws.NextReader(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err = wc.r.Read(p)
+		if err == io.EOF {
+			wc.r = nil
+			err = nil
 		}
-	}
 
-	n, err = wc.r.Read(p)
-	if err == io.EOF {
-		wc.r = nil
+		if err != nil {
+			return n, err
+		}
 	}
 
-	return
+	return n, nil
 }
 
 // Write represents write data for websocket connection.
+// Each write is sent as a websocket text message.
 func (wc *WebsocketServerConn) Write(p []byte) (n int, err error) {
 	if wc.w == nil {
 		wc.w, err = wc.Ws.NextWriter(websocket.TextMessage)
@@ -97,19 +195,17 @@ func (wc *WebsocketServerConn) Write(p []byte) (n int, err error) {
 
 	n, err = wc.w.Write(p)
 	if err != nil || n == len(p) {
-		err = wc.Close()
+		if closeErr := wc.w.Close(); err == nil {
+			err = closeErr
+		}
+
+		wc.w = nil
 	}
 
 	return
-
 }
 
 // Close represents close the websocket connection.
-func (wc *WebsocketServerConn) Close() (err error) {
-	if wc.w != nil {
-		err = wc.w.Close()
-		wc.w = nil
-	}
-
-	return
+func (wc *WebsocketServerConn) Close() error {
+	return wc.Ws.Close()
 }
@@ -6,6 +6,7 @@
 package seele
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/seeleteam/go-seele/common"
@@ -13,10 +14,21 @@ import (
 	"github.com/seeleteam/go-seele/core"
 	"github.com/seeleteam/go-seele/core/store"
 	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/miner"
 	"github.com/seeleteam/go-seele/p2p"
 )
 
+// maxLogsBlockRange is the max number of blocks scanned by a GetLogs request.
+const maxLogsBlockRange = 10000
+
+var (
+	errInvalidBlockRange  = errors.New("invalid block range")
+	errBlockRangeTooLarge = errors.New("block range too large")
+	errInvalidRawTx       = errors.New("invalid raw transaction")
+	errNotMultiSigAccount = errors.New("not a multisig account")
+)
+
 // PublicSeeleAPI provides an API to access full node-related information.
 type PublicSeeleAPI struct {
 	s *SeeleService
@@ -58,6 +70,32 @@ type GetTxByBlockHashAndIndexRequest struct {
 	Index   int
 }
 
+// GetContractAddressRequest request param for GetContractAddress api
+type GetContractAddressRequest struct {
+	From  common.Address
+	Nonce uint64
+}
+
+// LogFilterRequest request param to filter contract logs
+type LogFilterRequest struct {
+	Addresses []common.Address // the contract addresses, matches any address if empty
+	Topics    [][]string       // the topics in HEX at each position, matches any topic at the position if empty
+}
+
+// GetLogsRequest request param for GetLogs api
+type GetLogsRequest struct {
+	FromHeight int64 // the start block height, -1 for the chain head
+	ToHeight   int64 // the end block height, -1 for the chain head
+	LogFilterRequest
+}
+
+// VerifyMessageRequest request param for VerifyMessage api
+type VerifyMessageRequest struct {
+	Address   common.Address
+	Message   string // personal message in HEX
+	Signature string // signature in HEX
+}
+
 // GetInfo gets the account address that mining rewards will be send to.
 func (api *PublicSeeleAPI) GetInfo(input interface{}, info *MinerInfo) error {
 	block, _ := api.s.chain.CurrentBlock()
@@ -95,6 +133,27 @@ func (api *PublicSeeleAPI) AddTx(tx *types.Transaction, result *bool) error {
 	return nil
 }
 
+// SendRawTransaction decodes the signed transaction in RLP HEX, e.g. signed offline,
+// and adds it to the transaction pool. It returns the transaction hash.
+func (api *PublicSeeleAPI) SendRawTransaction(rawTx *string, result *string) error {
+	encoded, err := hexutil.HexToBytes(*rawTx)
+	if err != nil {
+		return err
+	}
+
+	tx := new(types.Transaction)
+	if err = common.Deserialize(encoded, tx); err != nil || tx.Data == nil || (tx.Signature == nil && len(tx.Signatures) == 0) {
+		return errInvalidRawTx
+	}
+
+	if err = api.s.txPool.AddTransaction(tx); err != nil {
+		return err
+	}
+
+	*result = tx.Hash.ToHex()
+	return nil
+}
+
 // GetAccountNonce get account next used nonce
 func (api *PublicSeeleAPI) GetAccountNonce(account *common.Address, nonce *uint64) error {
 	state := api.s.chain.CurrentState()
@@ -103,6 +162,46 @@ func (api *PublicSeeleAPI) GetAccountNonce(account *common.Address, nonce *uint6
 	return nil
 }
 
+// GetMultiSigAccount gets the owners and threshold of the multisig account
+func (api *PublicSeeleAPI) GetMultiSigAccount(account *common.Address, result *types.MultiSigAccount) error {
+	multiSig := api.s.chain.CurrentState().GetMultiSig(*account)
+	if multiSig == nil {
+		return errNotMultiSigAccount
+	}
+
+	*result = *multiSig
+	return nil
+}
+
+// VerifyMessage returns whether the personal message is signed by the address,
+// e.g. the signature of personal.Sign, which proves the control of the address.
+func (api *PublicSeeleAPI) VerifyMessage(request *VerifyMessageRequest, result *bool) error {
+	message, err := hexutil.HexToBytes(request.Message)
+	if err != nil {
+		return err
+	}
+
+	sigBytes, err := hexutil.HexToBytes(request.Signature)
+	if err != nil {
+		return err
+	}
+
+	sig, err := crypto.BytesToSignature(sigBytes)
+	if err != nil {
+		return err
+	}
+
+	*result = crypto.VerifyMessage(&request.Address, message, sig)
+	return nil
+}
+
+// GetContractAddress returns the address of contract created by the specified account with the specified nonce.
+// It could be used to predict the contract address before the contract creation tx is mined.
+func (api *PublicSeeleAPI) GetContractAddress(request *GetContractAddressRequest, result *common.Address) error {
+	*result = crypto.CreateAddress(request.From, request.Nonce)
+	return nil
+}
+
 // GetBlockHeight get the block height of the chain head
 func (api *PublicSeeleAPI) GetBlockHeight(input interface{}, height *uint64) error {
 	block, _ := api.s.chain.CurrentBlock()
@@ -152,6 +251,70 @@ func (api *PublicSeeleAPI) GetBlockByHash(request *GetBlockByHashRequest, result
 	return nil
 }
 
+// GetLogs returns the contract logs that match the specified filter in the block range [FromHeight, ToHeight].
+// The logs bloom filter in block header is used to skip the blocks that have no matched logs. The ToHeight
+// beyond the chain head is clamped to the head, and at most maxLogsBlockRange blocks are scanned.
+func (api *PublicSeeleAPI) GetLogs(request *GetLogsRequest, result *[]map[string]interface{}) error {
+	filter, err := newLogFilter(request.Addresses, request.Topics)
+	if err != nil {
+		return err
+	}
+
+	head, _ := api.s.chain.CurrentBlock()
+	fromHeight, toHeight, err := logsBlockRange(request.FromHeight, request.ToHeight, head.Header.Height)
+	if err != nil {
+		return err
+	}
+
+	store := api.s.chain.GetStore()
+	logs := make([]map[string]interface{}, 0)
+	for height := fromHeight; height <= toHeight; height++ {
+		hash, err := store.GetBlockHash(height)
+		if err != nil {
+			return err
+		}
+
+		header, err := store.GetBlockHeader(hash)
+		if err != nil {
+			return err
+		}
+
+		blockLogs, err := filter.filterBlock(store, hash, header)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range blockLogs {
+			logs = append(logs, rpcOutputLog(log))
+		}
+	}
+
+	*result = logs
+	return nil
+}
+
+// logsBlockRange returns the block range of GetLogs request, where -1 is the chain head and
+// the end height beyond the head is clamped to the head. The range is limited to maxLogsBlockRange.
+func logsBlockRange(fromHeight, toHeight int64, head uint64) (uint64, uint64, error) {
+	if fromHeight == -1 {
+		fromHeight = int64(head)
+	}
+
+	if toHeight == -1 || toHeight > int64(head) {
+		toHeight = int64(head)
+	}
+
+	if fromHeight < 0 || fromHeight > toHeight {
+		return 0, 0, errInvalidBlockRange
+	}
+
+	if toHeight-fromHeight >= maxLogsBlockRange {
+		return 0, 0, errBlockRangeTooLarge
+	}
+
+	return uint64(fromHeight), uint64(toHeight), nil
+}
+
 // PrivateNetworkAPI provides an API to access network information.
 type PrivateNetworkAPI struct {
 	s *SeeleService
@@ -240,6 +403,7 @@ func rpcOutputBlock(b *types.Block, fullTx bool, store store.BlockchainStore) (m
 		"creator":    head.Creator.ToHex(),
 		"timestamp":  head.CreateTimestamp,
 		"difficulty": head.Difficulty,
+		"logsBloom":  hexutil.BytesToHex(head.LogsBloom[:]),
 	}
 
 	txs := b.Transactions
@@ -264,10 +428,16 @@ func rpcOutputBlock(b *types.Block, fullTx bool, store store.BlockchainStore) (m
 
 // rpcOutputTx converts the given tx to the RPC output
 func rpcOutputTx(tx *types.Transaction) map[string]interface{} {
+	// the receiver is empty for contract creation tx
+	to := ""
+	if tx.Data.To != nil {
+		to = tx.Data.To.ToHex()
+	}
+
 	transaction := map[string]interface{}{
 		"hash":         tx.Hash.ToHex(),
 		"from":         tx.Data.From.ToHex(),
-		"to":           tx.Data.To.ToHex(),
+		"to":           to,
 		"amount":       tx.Data.Amount,
 		"accountNonce": tx.Data.AccountNonce,
 		"payload":      tx.Data.Payload,
@@ -276,6 +446,22 @@ func rpcOutputTx(tx *types.Transaction) map[string]interface{} {
 	return transaction
 }
 
+// rpcOutputLog converts the given log to the RPC output
+func rpcOutputLog(log *types.Log) map[string]interface{} {
+	topics := make([]string, len(log.Topics))
+	for i, topic := range log.Topics {
+		topics[i] = topic.ToHex()
+	}
+
+	return map[string]interface{}{
+		"address":          log.Address.ToHex(),
+		"topics":           topics,
+		"data":             hexutil.BytesToHex(log.Data),
+		"blockNumber":      log.BlockNumber,
+		"transactionIndex": log.TxIndex,
+	}
+}
+
 // getBlock returns block by height,when height is -1 the chain head is returned
 func getBlock(chain *core.Blockchain, height int64) (*types.Block, error) {
 	var block *types.Block
@@ -0,0 +1,63 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+// NewBlockFilter creates a filter to poll the hashes of new HEAD blocks, and returns the filter id.
+func (api *PublicSeeleAPI) NewBlockFilter(input interface{}, id *string) error {
+	filterID, err := api.s.filters.install(SubscriptionNewHeads, nil)
+	if err != nil {
+		return err
+	}
+
+	*id = filterID
+	return nil
+}
+
+// NewPendingTransactionFilter creates a filter to poll the hashes of new transactions
+// inserted into txpool, and returns the filter id.
+func (api *PublicSeeleAPI) NewPendingTransactionFilter(input interface{}, id *string) error {
+	filterID, err := api.s.filters.install(SubscriptionNewPendingTxs, nil)
+	if err != nil {
+		return err
+	}
+
+	*id = filterID
+	return nil
+}
+
+// NewLogFilter creates a filter to poll the matched contract logs in new HEAD blocks, and returns the filter id.
+func (api *PublicSeeleAPI) NewLogFilter(request *LogFilterRequest, id *string) error {
+	filter, err := newLogFilter(request.Addresses, request.Topics)
+	if err != nil {
+		return err
+	}
+
+	filterID, err := api.s.filters.install(SubscriptionLogs, filter)
+	if err != nil {
+		return err
+	}
+
+	*id = filterID
+	return nil
+}
+
+// GetFilterChanges returns the changes of the specified filter since last poll.
+// Note, the filter will be uninstalled if not polled for a while.
+func (api *PublicSeeleAPI) GetFilterChanges(id *string, result *[]interface{}) error {
+	changes, err := api.s.filters.changes(*id)
+	if err != nil {
+		return err
+	}
+
+	*result = changes
+	return nil
+}
+
+// UninstallFilter uninstalls the specified filter, and returns false if the filter not found.
+func (api *PublicSeeleAPI) UninstallFilter(id *string, result *bool) error {
+	*result = api.s.filters.uninstall(*id)
+	return nil
+}
@@ -0,0 +1,185 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+var (
+	errInvalidAmount = errors.New("invalid amount or fee")
+	errNonceUsed     = errors.New("nonce used by another transaction while signing, please send again")
+)
+
+// UnlockRequest request param for Unlock api
+type UnlockRequest struct {
+	Address  common.Address
+	Password string
+	Duration uint64 // seconds to keep the account unlocked, until locked if 0
+}
+
+// SendTxRequest request param for SendTransaction api
+type SendTxRequest struct {
+	From     common.Address
+	To       *common.Address // nil for contract creation transaction
+	Amount   *big.Int
+	Fee      *big.Int
+	Payload  string // payload in HEX, e.g. contract code or message
+	Password string // signs with the account decrypted by password if specified, otherwise the unlocked account
+}
+
+// SignRequest request param for Sign api
+type SignRequest struct {
+	Address  common.Address
+	Data     string // personal message in HEX to sign
+	Password string // signs with the account decrypted by password if specified, otherwise the unlocked account
+}
+
+// PrivatePersonalAPI provides an API to manage the accounts in the node keystore,
+// and sign with them in node so that the private keys are not shipped around.
+type PrivatePersonalAPI struct {
+	s         *SeeleService
+	nonceLock sync.Mutex
+}
+
+// NewPrivatePersonalAPI creates a new PrivatePersonalAPI object for personal rpc service.
+func NewPrivatePersonalAPI(s *SeeleService) *PrivatePersonalAPI {
+	return &PrivatePersonalAPI{s: s}
+}
+
+// ListAccounts returns the accounts in keystore.
+func (api *PrivatePersonalAPI) ListAccounts(input interface{}, result *[]accounts.Account) error {
+	accounts, err := api.s.accountManager.Accounts()
+	if err != nil {
+		return err
+	}
+
+	*result = accounts
+	return nil
+}
+
+// NewAccount creates an account in keystore encrypted with the password.
+func (api *PrivatePersonalAPI) NewAccount(password *string, result *common.Address) error {
+	account, err := api.s.accountManager.NewAccount(*password)
+	if err != nil {
+		return err
+	}
+
+	*result = account.Address
+	return nil
+}
+
+// Unlock unlocks the account with the password for the specified duration.
+func (api *PrivatePersonalAPI) Unlock(request *UnlockRequest, result *bool) error {
+	duration := time.Duration(request.Duration) * time.Second
+	if err := api.s.accountManager.Unlock(request.Address, request.Password, duration); err != nil {
+		return err
+	}
+
+	*result = true
+	return nil
+}
+
+// Lock locks the unlocked account.
+func (api *PrivatePersonalAPI) Lock(address *common.Address, result *bool) error {
+	api.s.accountManager.Lock(*address)
+	*result = true
+	return nil
+}
+
+// SendTransaction signs the transaction with the account of sender and adds it to the
+// transaction pool with the next nonce of the sender. It returns the transaction hash.
+func (api *PrivatePersonalAPI) SendTransaction(request *SendTxRequest, result *string) error {
+	if request.Amount == nil || request.Amount.Sign() < 0 || request.Fee == nil {
+		return errInvalidAmount
+	}
+
+	var payload []byte
+	if len(request.Payload) > 0 {
+		var err error
+		if payload, err = hexutil.HexToBytes(request.Payload); err != nil {
+			return err
+		}
+	}
+
+	// the nonce is reserved until the transaction is added
+	api.nonceLock.Lock()
+	defer api.nonceLock.Unlock()
+
+	nonce := api.s.txPool.GetPendingNonce(request.From)
+
+	var tx *types.Transaction
+	var err error
+	if request.To == nil {
+		tx, err = types.NewContractTransaction(request.From, request.Amount, request.Fee, nonce, payload)
+	} else {
+		tx, err = types.NewMessageTransaction(request.From, *request.To, request.Amount, request.Fee, nonce, payload)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(request.Password) > 0:
+		err = api.s.accountManager.SignTxWithPassword(tx, request.Password)
+	case api.s.accountManager.Unlocked(request.From):
+		err = api.s.accountManager.SignTx(tx)
+	default:
+		// the external signer may wait long for the user approval, so the nonce
+		// lock is released while signing, and the nonce is checked again after.
+		api.nonceLock.Unlock()
+		err = api.s.accountManager.SignTx(tx)
+		api.nonceLock.Lock()
+
+		if err == nil && api.s.txPool.GetPendingNonce(request.From) != nonce {
+			err = errNonceUsed
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err = api.s.txPool.AddTransaction(tx); err != nil {
+		return err
+	}
+
+	*result = tx.Hash.ToHex()
+	return nil
+}
+
+// Sign signs the personal message with the account, and returns the signature in HEX,
+// which could be verified with seele.VerifyMessage to prove the control of address.
+func (api *PrivatePersonalAPI) Sign(request *SignRequest, result *string) error {
+	data, err := hexutil.HexToBytes(request.Data)
+	if err != nil {
+		return err
+	}
+
+	var sig *crypto.Signature
+	if len(request.Password) > 0 {
+		sig, err = api.s.accountManager.SignHashWithPassword(request.Address, crypto.MessageHash(data).Bytes(), request.Password)
+	} else {
+		sig, err = api.s.accountManager.SignMessage(request.Address, data)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*result = hexutil.BytesToHex(sig.Bytes())
+	return nil
+}
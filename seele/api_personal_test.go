@@ -0,0 +1,186 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/common/keystore"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/seeleteam/go-seele/log"
+)
+
+// newTestPersonalService creates a seele service with the balances of accounts in genesis.
+func newTestPersonalService(t *testing.T, addresses ...*common.Address) (*SeeleService, func()) {
+	dataDir, err := ioutil.TempDir("", "personal")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := getTmpConfig()
+	conf.SeeleConfig.GenesisConfig.Accounts = make(map[common.Address]*big.Int)
+	for _, address := range addresses {
+		conf.SeeleConfig.GenesisConfig.Accounts[*address] = big.NewInt(100)
+	}
+
+	ctx := context.WithValue(context.Background(), "ServiceContext", ServiceContext{DataDir: dataDir})
+	s, err := NewSeeleService(ctx, conf, log.GetLogger("seele", true))
+	if err != nil {
+		os.RemoveAll(dataDir)
+		t.Fatal(err)
+	}
+
+	return s, func() {
+		s.chainDB.Close()
+		s.accountStateDB.Close()
+		os.RemoveAll(dataDir)
+	}
+}
+
+func Test_PrivatePersonalAPI(t *testing.T) {
+	from, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, dispose := newTestPersonalService(t, from)
+	defer dispose()
+
+	_, err = s.AccountManager().Import(&keystore.Key{Address: *from, PrivateKey: privateKey}, "123")
+	assert.Equal(t, err, nil)
+
+	api := NewPrivatePersonalAPI(s)
+
+	var list []accounts.Account
+	assert.Equal(t, api.ListAccounts(nil, &list), nil)
+	assert.Equal(t, len(list), 1)
+	assert.Equal(t, list[0].Address, *from)
+
+	// signed with the unlocked account
+	to := crypto.MustGenerateRandomAddress()
+	request := &SendTxRequest{From: *from, To: to, Amount: big.NewInt(10), Fee: big.NewInt(1)}
+
+	var hash string
+	assert.Equal(t, api.SendTransaction(request, &hash) != nil, true)
+
+	var unlocked bool
+	assert.Equal(t, api.Unlock(&UnlockRequest{Address: *from, Password: "123"}, &unlocked), nil)
+	assert.Equal(t, api.SendTransaction(request, &hash), nil)
+
+	txHash, _ := common.HexToHash(hash)
+	tx := s.TxPool().GetTransaction(txHash)
+	assert.Equal(t, tx.Data.AccountNonce, uint64(0))
+	assert.Equal(t, tx.Signature.Verify(from, tx.Hash.Bytes()), true)
+
+	// the next nonce is used for the pending transaction
+	assert.Equal(t, api.SendTransaction(request, &hash), nil)
+	txHash, _ = common.HexToHash(hash)
+	assert.Equal(t, s.TxPool().GetTransaction(txHash).Data.AccountNonce, uint64(1))
+
+	// the nonce of dropped transaction is reused
+	s.TxPool().RemoveTransaction(txHash)
+	assert.Equal(t, api.SendTransaction(request, &hash), nil)
+	txHash, _ = common.HexToHash(hash)
+	assert.Equal(t, s.TxPool().GetTransaction(txHash).Data.AccountNonce, uint64(1))
+
+	// signed with password
+	assert.Equal(t, api.Lock(from, &unlocked), nil)
+
+	var sigHex string
+	signRequest := &SignRequest{Address: *from, Data: hexutil.BytesToHex([]byte("message"))}
+	assert.Equal(t, api.Sign(signRequest, &sigHex) != nil, true)
+
+	signRequest.Password = "123"
+	assert.Equal(t, api.Sign(signRequest, &sigHex), nil)
+
+	var valid bool
+	verifyRequest := &VerifyMessageRequest{Address: *from, Message: signRequest.Data, Signature: sigHex}
+	assert.Equal(t, NewPublicSeeleAPI(s).VerifyMessage(verifyRequest, &valid), nil)
+	assert.Equal(t, valid, true)
+
+	verifyRequest.Message = hexutil.BytesToHex([]byte("other message"))
+	assert.Equal(t, NewPublicSeeleAPI(s).VerifyMessage(verifyRequest, &valid), nil)
+	assert.Equal(t, valid, false)
+}
+
+// blockingSigner is the external signer which waits for the approval to sign.
+type blockingSigner struct {
+	key      *ecdsa.PrivateKey
+	signing  chan struct{} // notified when the signing starts
+	approved chan struct{} // closed to approve the signing
+}
+
+func (signer *blockingSigner) Accounts() ([]common.Address, error) {
+	return nil, nil
+}
+
+func (signer *blockingSigner) SignTx(tx *types.Transaction) error {
+	signer.signing <- struct{}{}
+	<-signer.approved
+	tx.Sign(signer.key)
+	return nil
+}
+
+func (signer *blockingSigner) SignMessage(address common.Address, message []byte) (*crypto.Signature, error) {
+	return crypto.SignMessage(signer.key, message), nil
+}
+
+func Test_PrivatePersonalAPI_ExternalSigner(t *testing.T) {
+	from, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, otherKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, dispose := newTestPersonalService(t, from, other)
+	defer dispose()
+
+	signer := &blockingSigner{privateKey, make(chan struct{}), make(chan struct{})}
+	s.AccountManager().SetExternalSigner(signer)
+
+	for _, key := range []*keystore.Key{{Address: *from, PrivateKey: privateKey}, {Address: *other, PrivateKey: otherKey}} {
+		if _, err = s.AccountManager().Import(key, "123"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	api := NewPrivatePersonalAPI(s)
+	to := crypto.MustGenerateRandomAddress()
+
+	// signed in external signer which is waiting for approval
+	errs := make(chan error)
+	go func() {
+		var hash string
+		errs <- api.SendTransaction(&SendTxRequest{From: *from, To: to, Amount: big.NewInt(10), Fee: big.NewInt(1)}, &hash)
+	}()
+	<-signer.signing
+
+	// the other transactions are not blocked
+	var unlocked bool
+	assert.Equal(t, api.Unlock(&UnlockRequest{Address: *other, Password: "123"}, &unlocked), nil)
+
+	var hash string
+	assert.Equal(t, api.SendTransaction(&SendTxRequest{From: *other, To: to, Amount: big.NewInt(10), Fee: big.NewInt(1)}, &hash), nil)
+	assert.Equal(t, api.SendTransaction(&SendTxRequest{From: *from, To: to, Amount: big.NewInt(10), Fee: big.NewInt(1), Password: "123"}, &hash), nil)
+
+	// the nonce is used by the transaction signed with password meanwhile
+	close(signer.approved)
+	assert.Equal(t, <-errs, errNonceUsed)
+}
@@ -0,0 +1,122 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/event"
+)
+
+var (
+	errUnknownSubscription = errors.New("unknown subscription")
+)
+
+// SyncingStatus represents the block synchronization status in syncing subscription.
+type SyncingStatus struct {
+	Syncing bool // indicates whether the node is synchronizing blocks
+	Failed  bool // indicates whether the last synchronization failed
+}
+
+// PublicSubscriptionAPI provides an API to subscribe new heads, pending transactions,
+// logs and syncing status, which is only available on persistent connections, e.g. websocket.
+type PublicSubscriptionAPI struct {
+	s *SeeleService
+}
+
+// NewPublicSubscriptionAPI creates a new PublicSubscriptionAPI object for rpc service.
+func NewPublicSubscriptionAPI(s *SeeleService) *PublicSubscriptionAPI {
+	return &PublicSubscriptionAPI{s}
+}
+
+// Subscribe creates a subscription of the specified name, which is one of newHeads,
+// newPendingTransactions, logs and syncing. For logs subscription, the args is a
+// LogFilterRequest to filter the logs in new blocks.
+// This is to implement the rpc.Subscriber interface.
+func (api *PublicSubscriptionAPI) Subscribe(name string, args *json.RawMessage, notify func(data interface{}) error) (func(), error) {
+	var filter *logFilter
+
+	switch name {
+	case SubscriptionNewHeads, SubscriptionNewPendingTxs, SubscriptionSyncing:
+	case SubscriptionLogs:
+		var request LogFilterRequest
+		if args != nil {
+			if err := json.Unmarshal(*args, &request); err != nil {
+				return nil, err
+			}
+		}
+
+		var err error
+		if filter, err = newLogFilter(request.Addresses, request.Topics); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errUnknownSubscription
+	}
+
+	sub := api.s.events.subscribe(name)
+
+	go func() {
+		defer sub.unsubscribe()
+
+		for {
+			select {
+			case e := <-sub.events:
+				if err := api.notify(sub.kind, e, filter, notify); err != nil {
+					api.s.log.Debug("failed to push %s notification, %s", sub.kind, err)
+					return
+				}
+			case <-sub.quit:
+				return
+			}
+		}
+	}()
+
+	return sub.unsubscribe, nil
+}
+
+// notify converts the event to RPC output and pushes to the client.
+func (api *PublicSubscriptionAPI) notify(kind string, e event.Event, filter *logFilter, notify func(data interface{}) error) error {
+	switch kind {
+	case SubscriptionNewHeads:
+		output, err := rpcOutputBlock(e.(*types.Block), false, api.s.chain.GetStore())
+		if err != nil {
+			return err
+		}
+
+		return notify(output)
+	case SubscriptionNewPendingTxs:
+		return notify(e.(*types.Transaction).Hash.ToHex())
+	case SubscriptionLogs:
+		block := e.(*types.Block)
+		logs, err := filter.filterBlock(api.s.chain.GetStore(), block.HeaderHash, block.Header)
+		if err != nil {
+			return err
+		}
+
+		for _, log := range logs {
+			if err = notify(rpcOutputLog(log)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case SubscriptionSyncing:
+		return notify(newSyncingStatus(e))
+	default:
+		return errUnknownSubscription
+	}
+}
+
+// newSyncingStatus converts the downloader event to syncing status.
+func newSyncingStatus(e event.Event) SyncingStatus {
+	return SyncingStatus{
+		Syncing: e == event.DownloaderStartEvent,
+		Failed:  e == event.DownloaderFailedEvent,
+	}
+}
@@ -7,11 +7,16 @@ package seele
 import (
 	"bytes"
 	"context"
+	"io/ioutil"
+	"math/big"
 	"os"
 	"testing"
 
+	"github.com/magiconair/properties/assert"
 	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/common/hexutil"
 	"github.com/seeleteam/go-seele/core"
+	"github.com/seeleteam/go-seele/core/types"
 	"github.com/seeleteam/go-seele/crypto"
 	"github.com/seeleteam/go-seele/log"
 	"github.com/seeleteam/go-seele/node"
@@ -52,3 +57,95 @@ func Test_PublicSeeleAPI(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_RPCOutputTx(t *testing.T) {
+	from := *crypto.MustGenerateRandomAddress()
+	to := *crypto.MustGenerateRandomAddress()
+
+	tx, err := types.NewTransaction(from, to, big.NewInt(1), big.NewInt(0), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, rpcOutputTx(tx)["to"], to.ToHex())
+
+	// contract creation tx
+	tx, err = types.NewContractTransaction(from, big.NewInt(0), big.NewInt(0), 0, []byte{0x00})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, rpcOutputTx(tx)["to"], "")
+}
+
+func Test_PublicSeeleAPI_SendRawTransaction(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "rawtx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dataDir)
+
+	from, privateKey, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := getTmpConfig()
+	conf.SeeleConfig.GenesisConfig.Accounts = map[common.Address]*big.Int{*from: big.NewInt(100)}
+
+	ctx := context.WithValue(context.Background(), "ServiceContext", ServiceContext{DataDir: dataDir})
+	s, err := NewSeeleService(ctx, conf, log.GetLogger("seele", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.chainDB.Close()
+	defer s.accountStateDB.Close()
+
+	api := NewPublicSeeleAPI(s)
+
+	tx, _ := types.NewTransaction(*from, *crypto.MustGenerateRandomAddress(), big.NewInt(10), big.NewInt(1), 0)
+	tx.Sign(privateKey)
+	rawTx := hexutil.BytesToHex(common.SerializePanic(tx))
+
+	var hash string
+	assert.Equal(t, api.SendRawTransaction(&rawTx, &hash), nil)
+	assert.Equal(t, hash, tx.Hash.ToHex())
+	assert.Equal(t, s.TxPool().GetTransaction(tx.Hash) != nil, true)
+
+	invalidTx := "0x1234"
+	assert.Equal(t, api.SendRawTransaction(&invalidTx, &hash), errInvalidRawTx)
+
+	// tampered transaction data
+	tx.Data.Amount = big.NewInt(20)
+	rawTx = hexutil.BytesToHex(common.SerializePanic(tx))
+	assert.Equal(t, api.SendRawTransaction(&rawTx, &hash), types.ErrHashMismatch)
+
+	var multiSig types.MultiSigAccount
+	assert.Equal(t, api.GetMultiSigAccount(from, &multiSig), errNotMultiSigAccount)
+}
+
+func Test_LogsBlockRange(t *testing.T) {
+	from, to, err := logsBlockRange(-1, -1, 100)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, from, uint64(100))
+	assert.Equal(t, to, uint64(100))
+
+	// clamped to the chain head
+	from, to, err = logsBlockRange(10, 1000, 100)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, from, uint64(10))
+	assert.Equal(t, to, uint64(100))
+
+	_, _, err = logsBlockRange(101, -1, 100)
+	assert.Equal(t, err, errInvalidBlockRange)
+
+	_, _, err = logsBlockRange(-2, 10, 100)
+	assert.Equal(t, err, errInvalidBlockRange)
+
+	// range limit
+	_, _, err = logsBlockRange(0, maxLogsBlockRange-1, maxLogsBlockRange*2)
+	assert.Equal(t, err, nil)
+
+	_, _, err = logsBlockRange(0, maxLogsBlockRange, maxLogsBlockRange*2)
+	assert.Equal(t, err, errBlockRangeTooLarge)
+}
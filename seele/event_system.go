@@ -0,0 +1,133 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/seeleteam/go-seele/event"
+)
+
+// subscription kinds
+const (
+	// SubscriptionNewHeads is the subscription of new HEAD blocks.
+	SubscriptionNewHeads = "newHeads"
+
+	// SubscriptionNewPendingTxs is the subscription of new transactions inserted into txpool.
+	SubscriptionNewPendingTxs = "newPendingTransactions"
+
+	// SubscriptionLogs is the subscription of contract logs in new HEAD blocks.
+	SubscriptionLogs = "logs"
+
+	// SubscriptionSyncing is the subscription of block synchronization status.
+	SubscriptionSyncing = "syncing"
+)
+
+// eventBufferSize is the number of events buffered for a subscription,
+// and new events will be dropped and flagged if the buffer is full.
+const eventBufferSize = 128
+
+// eventSystem listens to the node events and dispatches them to subscriptions.
+type eventSystem struct {
+	lock          sync.RWMutex
+	subscriptions map[*eventSubscription]struct{}
+}
+
+// eventSubscription represents a subscription of a kind of events.
+type eventSubscription struct {
+	kind    string
+	events  chan interface{}
+	dropped int32 // 1 if any event dropped since last reset, accessed atomically
+	quit    chan struct{}
+	once    sync.Once
+	system  *eventSystem
+}
+
+func newEventSystem() *eventSystem {
+	return &eventSystem{
+		subscriptions: make(map[*eventSubscription]struct{}),
+	}
+}
+
+// start registers the listeners to node events.
+func (es *eventSystem) start() {
+	event.BlockInsertedEventManager.AddListener(es.handleBlockInserted)
+	event.TransactionInsertedEventManager.AddListener(es.handleTxInserted)
+	event.BlockDownloaderEventManager.AddListener(es.handleDownloaderEvent)
+}
+
+// stop removes the listeners of node events.
+func (es *eventSystem) stop() {
+	event.BlockInsertedEventManager.RemoveListener(es.handleBlockInserted)
+	event.TransactionInsertedEventManager.RemoveListener(es.handleTxInserted)
+	event.BlockDownloaderEventManager.RemoveListener(es.handleDownloaderEvent)
+}
+
+// subscribe creates a subscription of the specified kind.
+func (es *eventSystem) subscribe(kind string) *eventSubscription {
+	sub := &eventSubscription{
+		kind:   kind,
+		events: make(chan interface{}, eventBufferSize),
+		quit:   make(chan struct{}),
+		system: es,
+	}
+
+	es.lock.Lock()
+	es.subscriptions[sub] = struct{}{}
+	es.lock.Unlock()
+
+	return sub
+}
+
+// unsubscribe cancels the subscription. It's safe to call multiple times.
+func (sub *eventSubscription) unsubscribe() {
+	sub.once.Do(func() {
+		sub.system.lock.Lock()
+		delete(sub.system.subscriptions, sub)
+		sub.system.lock.Unlock()
+
+		close(sub.quit)
+	})
+}
+
+// resetDropped clears the dropped flag, and returns whether any event was dropped.
+func (sub *eventSubscription) resetDropped() bool {
+	return atomic.SwapInt32(&sub.dropped, 0) == 1
+}
+
+// dispatch sends the event to all subscriptions of the specified kinds.
+// Note, it never blocks since the event managers fire events synchronously.
+func (es *eventSystem) dispatch(e event.Event, kinds ...string) {
+	es.lock.RLock()
+	defer es.lock.RUnlock()
+
+	for sub := range es.subscriptions {
+		for _, kind := range kinds {
+			if sub.kind != kind {
+				continue
+			}
+
+			select {
+			case sub.events <- e:
+			default:
+				atomic.StoreInt32(&sub.dropped, 1)
+			}
+		}
+	}
+}
+
+func (es *eventSystem) handleBlockInserted(e event.Event) {
+	es.dispatch(e, SubscriptionNewHeads, SubscriptionLogs)
+}
+
+func (es *eventSystem) handleTxInserted(e event.Event) {
+	es.dispatch(e, SubscriptionNewPendingTxs)
+}
+
+func (es *eventSystem) handleDownloaderEvent(e event.Event) {
+	es.dispatch(e, SubscriptionSyncing)
+}
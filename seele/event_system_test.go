@@ -0,0 +1,67 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/event"
+)
+
+func Test_EventSystem_Dispatch(t *testing.T) {
+	es := newEventSystem()
+
+	heads := es.subscribe(SubscriptionNewHeads)
+	logs := es.subscribe(SubscriptionLogs)
+	txs := es.subscribe(SubscriptionNewPendingTxs)
+	syncing := es.subscribe(SubscriptionSyncing)
+
+	block := &types.Block{}
+	es.handleBlockInserted(block)
+	assert.Equal(t, <-heads.events, block)
+	assert.Equal(t, <-logs.events, block)
+	assert.Equal(t, len(txs.events), 0)
+
+	tx := &types.Transaction{}
+	es.handleTxInserted(tx)
+	assert.Equal(t, <-txs.events, tx)
+	assert.Equal(t, len(heads.events), 0)
+
+	es.handleDownloaderEvent(event.DownloaderStartEvent)
+	assert.Equal(t, newSyncingStatus(<-syncing.events), SyncingStatus{Syncing: true})
+
+	es.handleDownloaderEvent(event.DownloaderFailedEvent)
+	assert.Equal(t, newSyncingStatus(<-syncing.events), SyncingStatus{Failed: true})
+}
+
+func Test_EventSystem_Unsubscribe(t *testing.T) {
+	es := newEventSystem()
+
+	sub := es.subscribe(SubscriptionNewHeads)
+	assert.Equal(t, len(es.subscriptions), 1)
+
+	sub.unsubscribe()
+	sub.unsubscribe()
+	assert.Equal(t, len(es.subscriptions), 0)
+
+	<-sub.quit
+
+	es.dispatch(&types.Block{}, SubscriptionNewHeads)
+	assert.Equal(t, len(sub.events), 0)
+}
+
+func Test_EventSystem_BufferFull(t *testing.T) {
+	es := newEventSystem()
+	sub := es.subscribe(SubscriptionNewHeads)
+
+	for i := 0; i < eventBufferSize+10; i++ {
+		es.dispatch(&types.Block{}, SubscriptionNewHeads)
+	}
+
+	assert.Equal(t, len(sub.events), eventBufferSize)
+}
@@ -0,0 +1,223 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"crypto/rand"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/seeleteam/go-seele/common/hexutil"
+	"github.com/seeleteam/go-seele/core/store"
+	"github.com/seeleteam/go-seele/core/types"
+)
+
+const (
+	// filterTimeout is the duration that a filter will be uninstalled
+	// if the client has not polled the changes of the filter.
+	filterTimeout = 5 * time.Minute
+
+	// expireIntervals is the number of expiration sweeps within the filter timeout,
+	// so that an expired filter is uninstalled soon after the timeout.
+	expireIntervals = 10
+
+	// maxFilters is the max number of filters installed by all clients.
+	maxFilters = 1000
+)
+
+var (
+	errFilterNotFound = errors.New("filter not found")
+	errTooManyFilters = errors.New("too many filters installed, uninstall the unused filters first")
+	errEventsDropped  = errors.New("events dropped since the filter was not polled in time, the pending changes are discarded")
+)
+
+// filterSystem manages the filters that are polled by clients for changes, e.g. HTTP clients
+// that could not hold a persistent connection for subscriptions.
+type filterSystem struct {
+	lock       sync.Mutex
+	events     *eventSystem
+	bcStore    store.BlockchainStore
+	filters    map[string]*pollFilter // filter id to filter
+	maxFilters int
+	timeout    time.Duration
+	quit       chan struct{}
+}
+
+// pollFilter is a filter that buffers the events since last poll.
+type pollFilter struct {
+	sub      *eventSubscription
+	logs     *logFilter // only used for logs filter
+	lastPoll time.Time
+}
+
+func newFilterSystem(events *eventSystem, bcStore store.BlockchainStore) *filterSystem {
+	return &filterSystem{
+		events:     events,
+		bcStore:    bcStore,
+		filters:    make(map[string]*pollFilter),
+		maxFilters: maxFilters,
+		timeout:    filterTimeout,
+	}
+}
+
+// start starts a goroutine to uninstall the expired filters.
+func (fs *filterSystem) start() {
+	fs.quit = make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(fs.timeout / expireIntervals)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				fs.expire()
+			case <-fs.quit:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the expiration goroutine and uninstalls all filters.
+func (fs *filterSystem) stop() {
+	close(fs.quit)
+
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+
+	for id, f := range fs.filters {
+		f.sub.unsubscribe()
+		delete(fs.filters, id)
+	}
+}
+
+// install creates a filter of the specified kind and returns the filter id.
+func (fs *filterSystem) install(kind string, logs *logFilter) (string, error) {
+	id, err := newFilterID()
+	if err != nil {
+		return "", err
+	}
+
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+
+	if len(fs.filters) >= fs.maxFilters {
+		return "", errTooManyFilters
+	}
+
+	fs.filters[id] = &pollFilter{
+		sub:      fs.events.subscribe(kind),
+		logs:     logs,
+		lastPoll: time.Now(),
+	}
+
+	return id, nil
+}
+
+// uninstall removes the filter of the specified id, and returns false if not found.
+func (fs *filterSystem) uninstall(id string) bool {
+	fs.lock.Lock()
+	f, ok := fs.filters[id]
+	delete(fs.filters, id)
+	fs.lock.Unlock()
+
+	if ok {
+		f.sub.unsubscribe()
+	}
+
+	return ok
+}
+
+// expire uninstalls the filters that have not been polled within timeout.
+func (fs *filterSystem) expire() {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+
+	for id, f := range fs.filters {
+		if time.Since(f.lastPoll) > fs.timeout {
+			f.sub.unsubscribe()
+			delete(fs.filters, id)
+		}
+	}
+}
+
+// changes returns the RPC outputs of the events since last poll of the specified filter:
+// block hashes for blocks filter, transaction hashes for pending transactions filter,
+// and matched logs for logs filter. If any event was dropped since the buffer is full,
+// the buffered events are discarded and errEventsDropped is returned, so that the
+// client could resynchronize, e.g. with GetLogs.
+func (fs *filterSystem) changes(id string) ([]interface{}, error) {
+	fs.lock.Lock()
+	f, ok := fs.filters[id]
+	if ok {
+		f.lastPoll = time.Now()
+	}
+	fs.lock.Unlock()
+
+	if !ok {
+		return nil, errFilterNotFound
+	}
+
+	if f.sub.resetDropped() {
+		for len(f.sub.events) > 0 {
+			<-f.sub.events
+		}
+
+		return nil, errEventsDropped
+	}
+
+	result := make([]interface{}, 0)
+	for {
+		select {
+		case e := <-f.sub.events:
+			outputs, err := fs.convert(f, e)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, outputs...)
+		default:
+			return result, nil
+		}
+	}
+}
+
+// convert converts the event to RPC outputs according to the filter kind.
+func (fs *filterSystem) convert(f *pollFilter, e interface{}) ([]interface{}, error) {
+	switch f.sub.kind {
+	case SubscriptionNewHeads:
+		return []interface{}{e.(*types.Block).HeaderHash.ToHex()}, nil
+	case SubscriptionNewPendingTxs:
+		return []interface{}{e.(*types.Transaction).Hash.ToHex()}, nil
+	case SubscriptionLogs:
+		block := e.(*types.Block)
+		logs, err := f.logs.filterBlock(fs.bcStore, block.HeaderHash, block.Header)
+		if err != nil {
+			return nil, err
+		}
+
+		var outputs []interface{}
+		for _, log := range logs {
+			outputs = append(outputs, rpcOutputLog(log))
+		}
+
+		return outputs, nil
+	default:
+		return nil, errUnknownSubscription
+	}
+}
+
+// newFilterID returns a random filter id in HEX.
+func newFilterID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hexutil.BytesToHex(id), nil
+}
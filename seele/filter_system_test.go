@@ -0,0 +1,113 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+)
+
+func Test_FilterSystem_Changes(t *testing.T) {
+	fs := newFilterSystem(newEventSystem(), nil)
+
+	blockFilterID, err := fs.install(SubscriptionNewHeads, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txFilterID, err := fs.install(SubscriptionNewPendingTxs, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block := &types.Block{HeaderHash: common.StringToHash("block")}
+	fs.events.handleBlockInserted(block)
+
+	tx := &types.Transaction{Hash: common.StringToHash("tx")}
+	fs.events.handleTxInserted(tx)
+
+	changes, err := fs.changes(blockFilterID)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, changes, []interface{}{block.HeaderHash.ToHex()})
+
+	changes, err = fs.changes(txFilterID)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, changes, []interface{}{tx.Hash.ToHex()})
+
+	// no changes since last poll
+	changes, err = fs.changes(blockFilterID)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, len(changes), 0)
+
+	// uninstall
+	assert.Equal(t, fs.uninstall(blockFilterID), true)
+	assert.Equal(t, fs.uninstall(blockFilterID), false)
+
+	_, err = fs.changes(blockFilterID)
+	assert.Equal(t, err, errFilterNotFound)
+	assert.Equal(t, len(fs.events.subscriptions), 1)
+}
+
+func Test_FilterSystem_Expire(t *testing.T) {
+	fs := newFilterSystem(newEventSystem(), nil)
+	fs.timeout = 10 * time.Millisecond
+
+	expiredID, err := fs.install(SubscriptionNewHeads, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(2 * fs.timeout)
+
+	activeID, err := fs.install(SubscriptionNewHeads, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fs.expire()
+
+	_, err = fs.changes(expiredID)
+	assert.Equal(t, err, errFilterNotFound)
+
+	_, err = fs.changes(activeID)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, len(fs.events.subscriptions), 1)
+}
+
+func Test_FilterSystem_Limits(t *testing.T) {
+	fs := newFilterSystem(newEventSystem(), nil)
+	fs.maxFilters = 1
+
+	id, err := fs.install(SubscriptionNewHeads, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = fs.install(SubscriptionNewHeads, nil)
+	assert.Equal(t, err, errTooManyFilters)
+	assert.Equal(t, len(fs.events.subscriptions), 1)
+
+	// events dropped if not polled in time
+	for i := 0; i <= eventBufferSize; i++ {
+		fs.events.handleBlockInserted(&types.Block{HeaderHash: common.BigToHash(big.NewInt(int64(i)))})
+	}
+
+	_, err = fs.changes(id)
+	assert.Equal(t, err, errEventsDropped)
+
+	// the filter is reset
+	block := &types.Block{HeaderHash: common.StringToHash("block")}
+	fs.events.handleBlockInserted(block)
+
+	changes, err := fs.changes(id)
+	assert.Equal(t, err, error(nil))
+	assert.Equal(t, changes, []interface{}{block.HeaderHash.ToHex()})
+}
@@ -0,0 +1,143 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/store"
+	"github.com/seeleteam/go-seele/core/types"
+)
+
+// logFilter is used to filter the contract logs by contract addresses and topics.
+type logFilter struct {
+	addresses []common.Address // matches any address if empty
+	topics    [][]common.Hash  // matches any topic at the position if the topics of the position is empty
+}
+
+// newLogFilter creates a log filter with the specified contract addresses and topics in HEX.
+func newLogFilter(addresses []common.Address, topicHexes [][]string) (*logFilter, error) {
+	topics := make([][]common.Hash, len(topicHexes))
+	for i, hexes := range topicHexes {
+		for _, hex := range hexes {
+			topic, err := common.HexToHash(hex)
+			if err != nil {
+				return nil, err
+			}
+
+			topics[i] = append(topics[i], topic)
+		}
+	}
+
+	return &logFilter{addresses, topics}, nil
+}
+
+// matchBloom indicates whether the specified bloom filter may contain the logs
+// that match the filter. Note, there may be false positive.
+func (f *logFilter) matchBloom(bloom types.Bloom) bool {
+	if len(f.addresses) > 0 {
+		matched := false
+		for _, addr := range f.addresses {
+			if bloom.Test(addr.Bytes()) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	for _, topics := range f.topics {
+		if len(topics) == 0 {
+			continue
+		}
+
+		matched := false
+		for _, topic := range topics {
+			if bloom.Test(topic.Bytes()) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchLog indicates whether the specified log matches the filter.
+func (f *logFilter) matchLog(log *types.Log) bool {
+	if len(f.addresses) > 0 && !containsAddress(f.addresses, log.Address) {
+		return false
+	}
+
+	if len(f.topics) > len(log.Topics) {
+		return false
+	}
+
+	for i, topics := range f.topics {
+		if len(topics) > 0 && !containsHash(topics, log.Topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterBlock returns the logs in the specified block that match the filter.
+// The derived fields, e.g. block number and tx index, are filled in the returned logs.
+func (f *logFilter) filterBlock(bcStore store.BlockchainStore, blockHash common.Hash, header *types.BlockHeader) ([]*types.Log, error) {
+	// The empty bloom filter indicates there is no log in the block, e.g. genesis block.
+	if header.LogsBloom == (types.Bloom{}) || !f.matchBloom(header.LogsBloom) {
+		return nil, nil
+	}
+
+	receipts, err := bcStore.GetReceiptsByBlockHash(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.Log
+	for i, receipt := range receipts {
+		if !f.matchBloom(receipt.Bloom) {
+			continue
+		}
+
+		for _, log := range receipt.Logs {
+			if f.matchLog(log) {
+				log.BlockNumber = header.Height
+				log.TxIndex = uint(i)
+				logs = append(logs, log)
+			}
+		}
+	}
+
+	return logs, nil
+}
+
+func containsAddress(addresses []common.Address, target common.Address) bool {
+	for _, addr := range addresses {
+		if addr.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsHash(hashes []common.Hash, target common.Hash) bool {
+	for _, hash := range hashes {
+		if hash.Equal(target) {
+			return true
+		}
+	}
+
+	return false
+}
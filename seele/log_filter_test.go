@@ -0,0 +1,63 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seele
+
+import (
+	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+)
+
+func newTestLog() *types.Log {
+	return &types.Log{
+		Address: common.BytesToAddress([]byte{1}),
+		Topics:  []common.Hash{common.StringToHash("topic1"), common.StringToHash("topic2")},
+	}
+}
+
+func Test_LogFilter_MatchLog(t *testing.T) {
+	log := newTestLog()
+	topic1, topic2 := log.Topics[0].ToHex(), log.Topics[1].ToHex()
+	topic3 := common.StringToHash("topic3").ToHex()
+
+	cases := []struct {
+		addresses []common.Address
+		topics    [][]string
+		matched   bool
+	}{
+		{nil, nil, true},
+		{[]common.Address{log.Address}, nil, true},
+		{[]common.Address{common.BytesToAddress([]byte{2})}, nil, false},
+		{nil, [][]string{{topic1}}, true},
+		{nil, [][]string{{topic2}}, false},
+		{nil, [][]string{nil, {topic2}}, true},
+		{nil, [][]string{{topic3, topic1}, {topic2}}, true},
+		{nil, [][]string{{topic1}, {topic2}, {topic3}}, false},
+	}
+
+	for i, c := range cases {
+		filter, err := newLogFilter(c.addresses, c.topics)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if filter.matchLog(log) != c.matched {
+			t.Fatalf("case %v: expected matched = %v", i, c.matched)
+		}
+
+		// bloom filter has no false negative
+		if c.matched && !filter.matchBloom(types.LogsBloom([]*types.Log{log})) {
+			t.Fatalf("case %v: bloom not matched", i)
+		}
+	}
+}
+
+func Test_LogFilter_InvalidTopic(t *testing.T) {
+	_, err := newLogFilter(nil, [][]string{{"invalid topic"}})
+	assert.Equal(t, err != nil, true)
+}
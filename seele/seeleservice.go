@@ -9,6 +9,8 @@ import (
 	"context"
 	"path/filepath"
 
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/accounts/signer"
 	"github.com/seeleteam/go-seele/common"
 	"github.com/seeleteam/go-seele/core"
 	"github.com/seeleteam/go-seele/core/store"
@@ -35,6 +37,9 @@ type SeeleService struct {
 	chainDB        database.Database // database used to store blocks.
 	accountStateDB database.Database // database used to store account state info.
 	miner          *miner.Miner
+	events         *eventSystem  // dispatches node events to RPC subscriptions.
+	filters        *filterSystem // manages the RPC filters polled by clients.
+	accountManager *accounts.Manager
 }
 
 // ServiceContext is a collection of service configuration inherited from node
@@ -51,12 +56,18 @@ func (s *SeeleService) Downloader() *downloader.Downloader {
 	return s.seeleProtocol.Downloader()
 }
 
+// AccountManager returns the manager of the accounts in the node keystore.
+func (s *SeeleService) AccountManager() *accounts.Manager {
+	return s.accountManager
+}
+
 // NewSeeleService create SeeleService
 func NewSeeleService(ctx context.Context, conf *node.Config, log *log.SeeleLog) (s *SeeleService, err error) {
 	s = &SeeleService{
 		log:       log,
 		networkID: conf.P2PConfig.NetworkID,
 		Coinbase:  conf.SeeleConfig.Coinbase,
+		events:    newEventSystem(),
 	}
 
 	serviceContext := ctx.Value("ServiceContext").(ServiceContext)
@@ -100,6 +111,7 @@ func NewSeeleService(ctx context.Context, conf *node.Config, log *log.SeeleLog)
 		return nil, err
 	}
 
+	s.filters = newFilterSystem(s.events, bcStore)
 	s.txPool = core.NewTransactionPool(conf.SeeleConfig.TxConf, s.chain)
 	s.seeleProtocol, err = NewSeeleProtocol(s, log)
 	if err != nil {
@@ -110,6 +122,10 @@ func NewSeeleService(ctx context.Context, conf *node.Config, log *log.SeeleLog)
 	}
 
 	s.miner = miner.NewMiner(s.Coinbase, s, s.log)
+	s.accountManager = accounts.NewManager(accounts.KeystoreDir(serviceContext.DataDir))
+	if len(conf.BasicConfig.ExternalSigner) > 0 {
+		s.accountManager.SetExternalSigner(signer.NewClient(conf.BasicConfig.ExternalSigner, 0))
+	}
 
 	return s, nil
 }
@@ -126,11 +142,16 @@ func (s *SeeleService) Start(srvr *p2p.Server) error {
 	s.p2pServer = srvr
 
 	s.seeleProtocol.Start()
+	s.events.start()
+	s.filters.start()
 	return nil
 }
 
 // Stop implements node.Service, terminating all internal goroutines.
 func (s *SeeleService) Stop() error {
+	s.accountManager.Close()
+	s.filters.stop()
+	s.events.stop()
 	s.seeleProtocol.Stop()
 
 	//TODO
@@ -150,6 +171,12 @@ func (s *SeeleService) APIs() (apis []rpc.API) {
 			Service:   NewPublicSeeleAPI(s),
 			Public:    true,
 		},
+		{
+			Namespace: "seele",
+			Version:   "1.0",
+			Service:   NewPublicSubscriptionAPI(s),
+			Public:    true,
+		},
 		{
 			Namespace: "txpool",
 			Version:   "1.0",
@@ -180,5 +207,11 @@ func (s *SeeleService) APIs() (apis []rpc.API) {
 			Service:   NewPrivateMinerAPI(s),
 			Public:    false,
 		},
+		{
+			Namespace: "personal",
+			Version:   "1.0",
+			Service:   NewPrivatePersonalAPI(s),
+			Public:    false,
+		},
 	}...)
 }
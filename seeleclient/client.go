@@ -0,0 +1,96 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	netrpc "net/rpc"
+	"reflect"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// Client is a typed client of the node RPC APIs. It is safe for concurrent use
+// by multiple goroutines, and all calls are canceled when the context is done.
+type Client struct {
+	rpc *rpc.Client
+}
+
+// NewClient creates a client with the specified JSON-RPC 2.0 client.
+func NewClient(c *rpc.Client) *Client {
+	return &Client{c}
+}
+
+// Dial connects to the node at the specified network address, e.g. "tcp"
+// with RPC address or "unix" with IPC endpoint.
+func Dial(network, address string) (*Client, error) {
+	return DialContext(context.Background(), network, address)
+}
+
+// DialContext connects to the node at the specified network address with context.
+func DialContext(ctx context.Context, network, address string) (*Client, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(rpc.NewClient(conn)), nil
+}
+
+// DialWebsocket connects to the node at the specified websocket url, and
+// the header is sent in handshake request, e.g. to authenticate.
+func DialWebsocket(ctx context.Context, url string, header http.Header) (*Client, error) {
+	dialer := *websocket.DefaultDialer
+	dialer.NetDial = func(network, address string) (net.Conn, error) {
+		var netDialer net.Dialer
+		return netDialer.DialContext(ctx, network, address)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		dialer.HandshakeTimeout = time.Until(deadline)
+	}
+
+	ws, _, err := dialer.Dial(url, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(rpc.NewClient(&rpc.WebsocketServerConn{Ws: ws})), nil
+}
+
+// Close closes the connection to the node.
+func (c *Client) Close() error {
+	return c.rpc.Close()
+}
+
+// Call calls the RPC method with the specified args, and stores the result
+// into the value pointed by result. The args could be nil if not required.
+func (c *Client) Call(ctx context.Context, method string, args interface{}, result interface{}) error {
+	// The result is decoded into a new value, since the pending call
+	// could not be canceled and the result may be written after context done.
+	resultType := reflect.TypeOf(result).Elem()
+	reply := reflect.New(resultType)
+
+	// The args is always passed by pointer, which is required by the codec for basic types.
+	call := c.rpc.Go(method, &args, reply.Interface(), make(chan *netrpc.Call, 1))
+
+	select {
+	case <-call.Done:
+		if call.Error != nil {
+			return call.Error
+		}
+
+		reflect.ValueOf(result).Elem().Set(reply.Elem())
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
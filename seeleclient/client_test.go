@@ -0,0 +1,139 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+type testSeeleAPI struct {
+	nonce   uint64
+	txs     []*types.Transaction
+	release chan struct{}
+}
+
+func (api *testSeeleAPI) GetBalance(account *common.Address, result *big.Int) error {
+	result.SetInt64(100)
+	return nil
+}
+
+func (api *testSeeleAPI) GetAccountNonce(account *common.Address, nonce *uint64) error {
+	*nonce = api.nonce
+	return nil
+}
+
+func (api *testSeeleAPI) AddTx(tx *types.Transaction, result *bool) error {
+	api.txs = append(api.txs, tx)
+	*result = true
+	return nil
+}
+
+func (api *testSeeleAPI) GetBlockHeight(input interface{}, height *uint64) error {
+	<-api.release
+	*height = 8
+	return nil
+}
+
+type testMinerAPI struct {
+	threads int
+}
+
+func (api *testMinerAPI) Start(threads *int, result *string) error {
+	api.threads = *threads
+	return nil
+}
+
+func newTestClient(t *testing.T) (*Client, *testSeeleAPI, *testMinerAPI) {
+	seeleAPI := &testSeeleAPI{nonce: 5, release: make(chan struct{})}
+	minerAPI := &testMinerAPI{}
+
+	server := rpc.NewServer()
+	if err := server.RegisterName("seele", seeleAPI); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := server.RegisterName("miner", minerAPI); err != nil {
+		t.Fatal(err)
+	}
+
+	cli, srv := net.Pipe()
+	go server.ServeCodec(rpc.NewJSONCodec(srv, &server.Server))
+
+	return NewClient(rpc.NewClient(cli)), seeleAPI, minerAPI
+}
+
+func Test_Client_Call(t *testing.T) {
+	client, _, minerAPI := newTestClient(t)
+	defer client.Close()
+
+	ctx := context.Background()
+
+	balance, err := client.GetBalance(ctx, *crypto.MustGenerateRandomAddress())
+	assert.Equal(t, err, nil)
+	assert.Equal(t, balance, big.NewInt(100))
+
+	nonce, err := client.GetAccountNonce(ctx, *crypto.MustGenerateRandomAddress())
+	assert.Equal(t, err, nil)
+	assert.Equal(t, nonce, uint64(5))
+
+	// basic type args
+	assert.Equal(t, client.StartMiner(ctx, 3), nil)
+	assert.Equal(t, minerAPI.threads, 3)
+
+	// method not found
+	_, err = client.GetPeerCount(ctx)
+	assert.Equal(t, err != nil, true)
+}
+
+func Test_Client_Timeout(t *testing.T) {
+	client, seeleAPI, _ := newTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := client.GetBlockHeight(ctx)
+	assert.Equal(t, err, context.DeadlineExceeded)
+
+	// the client is still available after the timed out call returned
+	close(seeleAPI.release)
+
+	height, err := client.GetBlockHeight(context.Background())
+	assert.Equal(t, err, nil)
+	assert.Equal(t, height, uint64(8))
+}
+
+func Test_Client_Transfer(t *testing.T) {
+	client, seeleAPI, _ := newTestClient(t)
+	defer client.Close()
+
+	from, key, err := crypto.GenerateKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	to := *crypto.MustGenerateRandomAddress()
+	tx, err := client.Transfer(context.Background(), key, to, big.NewInt(10), big.NewInt(1))
+	assert.Equal(t, err, nil)
+
+	assert.Equal(t, tx.Data.From, *from)
+	assert.Equal(t, *tx.Data.To, to)
+	assert.Equal(t, tx.Data.AccountNonce, uint64(5))
+
+	assert.Equal(t, len(seeleAPI.txs), 1)
+	assert.Equal(t, seeleAPI.txs[0].Hash, tx.Hash)
+	assert.Equal(t, seeleAPI.txs[0].Signature, tx.Signature)
+}
@@ -0,0 +1,26 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+
+	"github.com/seeleteam/go-seele/rpc"
+)
+
+// Modules returns the API namespaces served on the connected RPC service and their versions.
+func (c *Client) Modules(ctx context.Context) (map[string]string, error) {
+	var modules map[string]string
+	err := c.Call(ctx, rpc.MetadataAPI+".Modules", nil, &modules)
+	return modules, err
+}
+
+// Describe returns the methods of the specified API namespace, and all namespaces if empty.
+func (c *Client) Describe(ctx context.Context, namespace string) ([]*rpc.ModuleDescription, error) {
+	var modules []*rpc.ModuleDescription
+	err := c.Call(ctx, rpc.MetadataAPI+".Describe", namespace, &modules)
+	return modules, err
+}
@@ -0,0 +1,32 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+
+	"github.com/seeleteam/go-seele/monitor"
+)
+
+// NodeInfo returns the information of the node.
+func (c *Client) NodeInfo(ctx context.Context) (*monitor.NodeInfo, error) {
+	var info monitor.NodeInfo
+	if err := c.Call(ctx, "monitor.NodeInfo", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// NodeStats returns the statistics of the node, e.g. mining and syncing.
+func (c *Client) NodeStats(ctx context.Context) (*monitor.NodeStats, error) {
+	var stats monitor.NodeStats
+	if err := c.Call(ctx, "monitor.NodeStats", nil, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
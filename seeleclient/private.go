@@ -0,0 +1,182 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/seeleteam/go-seele/accounts"
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/p2p"
+	"github.com/seeleteam/go-seele/seele"
+	"github.com/seeleteam/go-seele/seele/download"
+)
+
+// Note, the methods in this file call the private APIs, which are only exposed
+// on the IPC endpoint by default, or require authentication if enabled.
+
+// GetBlockTransactionCountByHeight returns the count of transactions in the block of the specified height.
+func (c *Client) GetBlockTransactionCountByHeight(ctx context.Context, height int64) (int, error) {
+	var count int
+	err := c.Call(ctx, "txpool.GetBlockTransactionCountByHeight", height, &count)
+	return count, err
+}
+
+// GetBlockTransactionCountByHash returns the count of transactions in the block of the specified hash in HEX.
+func (c *Client) GetBlockTransactionCountByHash(ctx context.Context, hashHex string) (int, error) {
+	var count int
+	err := c.Call(ctx, "txpool.GetBlockTransactionCountByHash", hashHex, &count)
+	return count, err
+}
+
+// GetTransactionByBlockHeightAndIndex returns the transaction in the block of the specified height and index.
+func (c *Client) GetTransactionByBlockHeightAndIndex(ctx context.Context, height int64, index int) (map[string]interface{}, error) {
+	var tx map[string]interface{}
+	err := c.Call(ctx, "txpool.GetTransactionByBlockHeightAndIndex", seele.GetTxByBlockHeightAndIndexRequest{Height: height, Index: index}, &tx)
+	return tx, err
+}
+
+// GetTransactionByBlockHashAndIndex returns the transaction in the block of the specified hash and index.
+func (c *Client) GetTransactionByBlockHashAndIndex(ctx context.Context, hashHex string, index int) (map[string]interface{}, error) {
+	var tx map[string]interface{}
+	err := c.Call(ctx, "txpool.GetTransactionByBlockHashAndIndex", seele.GetTxByBlockHashAndIndexRequest{HashHex: hashHex, Index: index}, &tx)
+	return tx, err
+}
+
+// GetTransactionByHash returns the pending or finalized transaction of the specified hash in HEX.
+func (c *Client) GetTransactionByHash(ctx context.Context, hashHex string) (map[string]interface{}, error) {
+	var tx map[string]interface{}
+	err := c.Call(ctx, "txpool.GetTransactionByHash", hashHex, &tx)
+	return tx, err
+}
+
+// GetBlockRlp returns the RLP encoded block in HEX of the specified height, and -1 for the chain head.
+func (c *Client) GetBlockRlp(ctx context.Context, height int64) (string, error) {
+	var result string
+	err := c.Call(ctx, "debug.GetBlockRlp", height, &result)
+	return result, err
+}
+
+// PrintBlock returns the pretty printed block of the specified height, and -1 for the chain head.
+func (c *Client) PrintBlock(ctx context.Context, height int64) (string, error) {
+	var result string
+	err := c.Call(ctx, "debug.PrintBlock", height, &result)
+	return result, err
+}
+
+// GetTxPoolContent returns the processable transactions in tx pool grouped by account.
+func (c *Client) GetTxPoolContent(ctx context.Context) (map[string][]map[string]interface{}, error) {
+	var content map[string][]map[string]interface{}
+	err := c.Call(ctx, "debug.GetTxPoolContent", nil, &content)
+	return content, err
+}
+
+// GetTxPoolTxCount returns the number of processable transactions in tx pool.
+func (c *Client) GetTxPoolTxCount(ctx context.Context) (uint64, error) {
+	var count uint64
+	err := c.Call(ctx, "debug.GetTxPoolTxCount", nil, &count)
+	return count, err
+}
+
+// StartMiner starts the miner with the specified number of threads, and 0 for the number of CPUs.
+func (c *Client) StartMiner(ctx context.Context, threads int) error {
+	var result string
+	return c.Call(ctx, "miner.Start", threads, &result)
+}
+
+// StopMiner stops the miner.
+func (c *Client) StopMiner(ctx context.Context) error {
+	var result string
+	return c.Call(ctx, "miner.Stop", "", &result)
+}
+
+// Hashrate returns the POW hashrate of the miner.
+func (c *Client) Hashrate(ctx context.Context) (uint64, error) {
+	var hashrate uint64
+	err := c.Call(ctx, "miner.Hashrate", "", &hashrate)
+	return hashrate, err
+}
+
+// GetPeersInfo returns the information of connected peers.
+func (c *Client) GetPeersInfo(ctx context.Context) ([]p2p.PeerInfo, error) {
+	var peers []p2p.PeerInfo
+	err := c.Call(ctx, "network.GetPeersInfo", nil, &peers)
+	return peers, err
+}
+
+// GetPeerCount returns the count of connected peers.
+func (c *Client) GetPeerCount(ctx context.Context) (int, error) {
+	var count int
+	err := c.Call(ctx, "network.GetPeerCount", nil, &count)
+	return count, err
+}
+
+// GetNetworkVersion returns the network version.
+func (c *Client) GetNetworkVersion(ctx context.Context) (uint64, error) {
+	var version uint64
+	err := c.Call(ctx, "network.GetNetworkVersion", nil, &version)
+	return version, err
+}
+
+// GetProtocolVersion returns the seele protocol version of the node.
+func (c *Client) GetProtocolVersion(ctx context.Context) (uint, error) {
+	var version uint
+	err := c.Call(ctx, "network.GetProtocolVersion", nil, &version)
+	return version, err
+}
+
+// GetSyncStatus returns the block synchronization status of the downloader.
+func (c *Client) GetSyncStatus(ctx context.Context) (*downloader.SyncInfo, error) {
+	var info downloader.SyncInfo
+	if err := c.Call(ctx, "download.GetStatus", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// ListAccounts returns the accounts in the node keystore.
+func (c *Client) ListAccounts(ctx context.Context) ([]accounts.Account, error) {
+	var list []accounts.Account
+	err := c.Call(ctx, "personal.ListAccounts", nil, &list)
+	return list, err
+}
+
+// NewAccount creates an account in the node keystore encrypted with the password.
+func (c *Client) NewAccount(ctx context.Context, password string) (common.Address, error) {
+	var address common.Address
+	err := c.Call(ctx, "personal.NewAccount", password, &address)
+	return address, err
+}
+
+// UnlockAccount unlocks the account in the node keystore for the specified duration,
+// and the account is kept unlocked until locked if the duration is 0.
+func (c *Client) UnlockAccount(ctx context.Context, address common.Address, password string, duration time.Duration) error {
+	request := seele.UnlockRequest{Address: address, Password: password, Duration: uint64(duration / time.Second)}
+	return c.Call(ctx, "personal.Unlock", request, new(bool))
+}
+
+// LockAccount locks the unlocked account in the node keystore.
+func (c *Client) LockAccount(ctx context.Context, address common.Address) error {
+	return c.Call(ctx, "personal.Lock", address, new(bool))
+}
+
+// SendTransaction sends the transaction signed with the account in the node keystore,
+// and returns the transaction hash in HEX.
+func (c *Client) SendTransaction(ctx context.Context, request seele.SendTxRequest) (string, error) {
+	var hash string
+	err := c.Call(ctx, "personal.SendTransaction", request, &hash)
+	return hash, err
+}
+
+// Sign signs the personal message with the account in the node keystore,
+// and returns the signature in HEX.
+func (c *Client) Sign(ctx context.Context, request seele.SignRequest) (string, error) {
+	var sig string
+	err := c.Call(ctx, "personal.Sign", request, &sig)
+	return sig, err
+}
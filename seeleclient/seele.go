@@ -0,0 +1,143 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/seele"
+)
+
+// GetInfo returns the miner info, e.g. coinbase and chain head.
+func (c *Client) GetInfo(ctx context.Context) (*seele.MinerInfo, error) {
+	var info seele.MinerInfo
+	if err := c.Call(ctx, "seele.GetInfo", nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// GetBalance returns the balance of the specified account. The coinbase
+// balance is returned if the account is empty.
+func (c *Client) GetBalance(ctx context.Context, account common.Address) (*big.Int, error) {
+	balance := big.NewInt(0)
+	if err := c.Call(ctx, "seele.GetBalance", account, balance); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
+
+// GetAccountNonce returns the next nonce of the specified account.
+func (c *Client) GetAccountNonce(ctx context.Context, account common.Address) (uint64, error) {
+	var nonce uint64
+	err := c.Call(ctx, "seele.GetAccountNonce", account, &nonce)
+	return nonce, err
+}
+
+// AddTx submits the signed transaction to the tx pool of the node.
+func (c *Client) AddTx(ctx context.Context, tx *types.Transaction) error {
+	var result bool
+	return c.Call(ctx, "seele.AddTx", tx, &result)
+}
+
+// SendRawTransaction submits the signed transaction in RLP HEX to the tx pool of the node,
+// and returns the transaction hash.
+func (c *Client) SendRawTransaction(ctx context.Context, rawTx string) (string, error) {
+	var hash string
+	err := c.Call(ctx, "seele.SendRawTransaction", rawTx, &hash)
+	return hash, err
+}
+
+// GetMultiSigAccount returns the owners and threshold of the multisig account.
+func (c *Client) GetMultiSigAccount(ctx context.Context, account common.Address) (*types.MultiSigAccount, error) {
+	multiSig := new(types.MultiSigAccount)
+	err := c.Call(ctx, "seele.GetMultiSigAccount", account, multiSig)
+	return multiSig, err
+}
+
+// VerifyMessage returns whether the personal message is signed by the address.
+func (c *Client) VerifyMessage(ctx context.Context, request seele.VerifyMessageRequest) (bool, error) {
+	var valid bool
+	err := c.Call(ctx, "seele.VerifyMessage", request, &valid)
+	return valid, err
+}
+
+// GetContractAddress returns the address of contract created by the specified account with the specified nonce.
+func (c *Client) GetContractAddress(ctx context.Context, from common.Address, nonce uint64) (common.Address, error) {
+	var address common.Address
+	err := c.Call(ctx, "seele.GetContractAddress", seele.GetContractAddressRequest{From: from, Nonce: nonce}, &address)
+	return address, err
+}
+
+// GetBlockHeight returns the block height of the chain head.
+func (c *Client) GetBlockHeight(ctx context.Context) (uint64, error) {
+	var height uint64
+	err := c.Call(ctx, "seele.GetBlockHeight", nil, &height)
+	return height, err
+}
+
+// GetBlockByHeight returns the block of the specified height, and -1 for the chain head.
+// All transactions are returned in full detail if fullTx is true, otherwise only hashes.
+func (c *Client) GetBlockByHeight(ctx context.Context, height int64, fullTx bool) (map[string]interface{}, error) {
+	var block map[string]interface{}
+	err := c.Call(ctx, "seele.GetBlockByHeight", seele.GetBlockByHeightRequest{Height: height, FullTx: fullTx}, &block)
+	return block, err
+}
+
+// GetBlockByHash returns the block of the specified hash in HEX.
+// All transactions are returned in full detail if fullTx is true, otherwise only hashes.
+func (c *Client) GetBlockByHash(ctx context.Context, hashHex string, fullTx bool) (map[string]interface{}, error) {
+	var block map[string]interface{}
+	err := c.Call(ctx, "seele.GetBlockByHash", seele.GetBlockByHashRequest{HashHex: hashHex, FullTx: fullTx}, &block)
+	return block, err
+}
+
+// GetLogs returns the contract logs that match the filter in the specified block range.
+func (c *Client) GetLogs(ctx context.Context, request seele.GetLogsRequest) ([]map[string]interface{}, error) {
+	var logs []map[string]interface{}
+	err := c.Call(ctx, "seele.GetLogs", request, &logs)
+	return logs, err
+}
+
+// NewBlockFilter installs a polling filter of new HEAD blocks, and returns the filter id.
+func (c *Client) NewBlockFilter(ctx context.Context) (string, error) {
+	var id string
+	err := c.Call(ctx, "seele.NewBlockFilter", nil, &id)
+	return id, err
+}
+
+// NewPendingTransactionFilter installs a polling filter of new pending transactions, and returns the filter id.
+func (c *Client) NewPendingTransactionFilter(ctx context.Context) (string, error) {
+	var id string
+	err := c.Call(ctx, "seele.NewPendingTransactionFilter", nil, &id)
+	return id, err
+}
+
+// NewLogFilter installs a polling filter of contract logs, and returns the filter id.
+func (c *Client) NewLogFilter(ctx context.Context, request seele.LogFilterRequest) (string, error) {
+	var id string
+	err := c.Call(ctx, "seele.NewLogFilter", request, &id)
+	return id, err
+}
+
+// GetFilterChanges returns the changes of the specified filter since last poll.
+func (c *Client) GetFilterChanges(ctx context.Context, id string) ([]interface{}, error) {
+	var changes []interface{}
+	err := c.Call(ctx, "seele.GetFilterChanges", id, &changes)
+	return changes, err
+}
+
+// UninstallFilter uninstalls the specified filter, and returns false if not found.
+func (c *Client) UninstallFilter(ctx context.Context, id string) (bool, error) {
+	var result bool
+	err := c.Call(ctx, "seele.UninstallFilter", id, &result)
+	return result, err
+}
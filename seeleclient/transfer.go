@@ -0,0 +1,50 @@
+/**
+*  @file
+*  @copyright defined in go-seele/LICENSE
+ */
+
+package seeleclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/seeleteam/go-seele/common"
+	"github.com/seeleteam/go-seele/core/types"
+	"github.com/seeleteam/go-seele/crypto"
+)
+
+// Transfer builds a transaction to transfer the amount from the account of the
+// private key to the receiver with the next nonce of the sender, signs it and
+// submits to the node. The submitted transaction is returned.
+func (c *Client) Transfer(ctx context.Context, key *ecdsa.PrivateKey, to common.Address, amount, fee *big.Int) (*types.Transaction, error) {
+	from, err := crypto.GetAddress(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.TransferFrom(ctx, *from, key, to, amount, fee)
+}
+
+// TransferFrom is the same as Transfer except that the sender address is specified,
+// e.g. the short address of the private key.
+func (c *Client) TransferFrom(ctx context.Context, from common.Address, key *ecdsa.PrivateKey, to common.Address, amount, fee *big.Int) (*types.Transaction, error) {
+	nonce, err := c.GetAccountNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := types.NewTransaction(from, to, amount, fee, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.Sign(key)
+
+	if err = c.AddTx(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}